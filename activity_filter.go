@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EventCategory groups activity event types for quick show/hide toggles
+type EventCategory int
+
+const (
+	CategoryPushes EventCategory = iota
+	CategoryPullRequests
+	CategoryIssues
+	CategoryStars
+	CategoryForks
+	CategoryOther
+)
+
+// toggleableCategories are bound to the 1-5 keys, in order
+var toggleableCategories = []EventCategory{
+	CategoryPushes,
+	CategoryPullRequests,
+	CategoryIssues,
+	CategoryStars,
+	CategoryForks,
+}
+
+// String returns the display name for a category
+func (c EventCategory) String() string {
+	switch c {
+	case CategoryPushes:
+		return "pushes"
+	case CategoryPullRequests:
+		return "PRs"
+	case CategoryIssues:
+		return "issues"
+	case CategoryStars:
+		return "stars"
+	case CategoryForks:
+		return "forks"
+	default:
+		return "other"
+	}
+}
+
+// categoryOf returns the category of an event type
+func categoryOf(eventType string) EventCategory {
+	switch eventType {
+	case "PushEvent":
+		return CategoryPushes
+	case "PullRequestEvent", "PullRequestReviewEvent", "PullRequestReviewCommentEvent":
+		return CategoryPullRequests
+	case "IssuesEvent", "IssueCommentEvent":
+		return CategoryIssues
+	case "WatchEvent":
+		return CategoryStars
+	case "ForkEvent":
+		return CategoryForks
+	default:
+		return CategoryOther
+	}
+}
+
+// ActivityFilter narrows the Recent Activity table and the metrics derived from it
+type ActivityFilter struct {
+	Query  string                 // Case-insensitive match on repo, event type or action
+	Hidden map[EventCategory]bool // Categories to hide
+	Repo   string                 // Only show this repository, if set
+}
+
+// Active reports whether the filter hides anything
+func (f ActivityFilter) Active() bool {
+	if f.Query != "" || f.Repo != "" {
+		return true
+	}
+	for _, hidden := range f.Hidden {
+		if hidden {
+			return true
+		}
+	}
+	return false
+}
+
+// Toggle shows or hides a category
+func (f *ActivityFilter) Toggle(category EventCategory) {
+	if f.Hidden == nil {
+		f.Hidden = make(map[EventCategory]bool)
+	}
+	f.Hidden[category] = !f.Hidden[category]
+}
+
+// Match reports whether an activity passes the filter
+func (f ActivityFilter) Match(activity Activity) bool {
+	if f.Hidden[categoryOf(activity.Type)] {
+		return false
+	}
+	if f.Repo != "" && !strings.EqualFold(activity.Repo, f.Repo) {
+		return false
+	}
+	if f.Query == "" {
+		return true
+	}
+	query := strings.ToLower(f.Query)
+	for _, field := range []string{activity.Repo, activity.Type, activity.Action} {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply returns the activities that pass the filter
+func (f ActivityFilter) Apply(activities []Activity) []Activity {
+	if !f.Active() {
+		return activities
+	}
+	var matched []Activity
+	for _, activity := range activities {
+		if f.Match(activity) {
+			matched = append(matched, activity)
+		}
+	}
+	return matched
+}
+
+// String summarises the active filter, e.g. `"auth" in o/repo, hiding stars, forks`
+func (f ActivityFilter) String() string {
+	var parts []string
+	if f.Query != "" {
+		parts = append(parts, fmt.Sprintf("%q", f.Query))
+	}
+	if f.Repo != "" {
+		parts = append(parts, "in "+f.Repo)
+	}
+	var hidden []string
+	for _, category := range toggleableCategories {
+		if f.Hidden[category] {
+			hidden = append(hidden, category.String())
+		}
+	}
+	if len(hidden) > 0 {
+		parts = append(parts, "hiding "+strings.Join(hidden, ", "))
+	}
+	return strings.Join(parts, " ")
+}
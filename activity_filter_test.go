@@ -0,0 +1,42 @@
+package main
+
+import "testing"
+
+func TestActivityFilter(t *testing.T) {
+	activities := []Activity{
+		{Type: "PushEvent", Repo: "octo/api", Action: "Pushed 1 commit(s) to main: fix auth"},
+		{Type: "PullRequestEvent", Repo: "octo/api", Action: "Merged PR #42: fix auth"},
+		{Type: "WatchEvent", Repo: "other/lib", Action: "Starred repository"},
+		{Type: "ForkEvent", Repo: "other/lib", Action: "Forked to octo/lib"},
+	}
+
+	var f ActivityFilter
+	if f.Active() || len(f.Apply(activities)) != 4 {
+		t.Fatal("empty filter should pass everything")
+	}
+
+	f.Query = "AUTH"
+	if got := f.Apply(activities); len(got) != 2 {
+		t.Errorf("query matched %d activities, want 2", len(got))
+	}
+
+	f.Toggle(CategoryPushes)
+	if got := f.Apply(activities); len(got) != 1 || got[0].Type != "PullRequestEvent" {
+		t.Errorf("hiding pushes left %+v", got)
+	}
+
+	f = ActivityFilter{Repo: "OTHER/lib"}
+	f.Toggle(CategoryStars)
+	if got := f.Apply(activities); len(got) != 1 || got[0].Type != "ForkEvent" {
+		t.Errorf("repo + hidden stars left %+v", got)
+	}
+	if s := f.String(); s != "in OTHER/lib hiding stars" {
+		t.Errorf("String() = %q", s)
+	}
+
+	f.Toggle(CategoryStars)
+	f.Repo = ""
+	if f.Active() {
+		t.Error("filter with everything toggled back should be inactive")
+	}
+}
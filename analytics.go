@@ -0,0 +1,127 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// sparkRunes are the bar heights used by sparklines, lowest first
+var sparkRunes = []rune("▁▂▃▄▅▆▇█")
+
+// ContributionAnalytics summarises productivity patterns in a contribution calendar
+type ContributionAnalytics struct {
+	WeekdayAverages [7]float64 // Average contributions per weekday, indexed by time.Weekday
+	BestWeekday     time.Weekday
+	WorstWeekday    time.Weekday
+
+	WeeklyTotals []int // Totals per Sunday-started week, oldest first
+
+	// Contributions in the last 30 days vs the 30 days before, as a percentage change
+	MonthOverMonth    float64
+	HasMonthOverMonth bool // False when the earlier period has no contributions
+
+	// Consistency is 0-100: the mean of the share of active days and the share
+	// of weeks with at least one contribution
+	Consistency int
+}
+
+// AnalyzeContributions derives weekday, weekly and monthly analytics from a calendar
+func AnalyzeContributions(contributions []Contribution) ContributionAnalytics {
+	var analytics ContributionAnalytics
+	if len(contributions) == 0 {
+		return analytics
+	}
+
+	days := make([]Contribution, len(contributions))
+	copy(days, contributions)
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Date.Before(days[j].Date)
+	})
+
+	// Per-weekday averages
+	var weekdayTotals, weekdayDays [7]int
+	for _, day := range days {
+		weekday := day.Date.Weekday()
+		weekdayTotals[weekday] += day.Count
+		weekdayDays[weekday]++
+	}
+	for weekday := range weekdayTotals {
+		if weekdayDays[weekday] > 0 {
+			analytics.WeekdayAverages[weekday] = float64(weekdayTotals[weekday]) / float64(weekdayDays[weekday])
+		}
+	}
+	for weekday, avg := range analytics.WeekdayAverages {
+		if avg > analytics.WeekdayAverages[analytics.BestWeekday] {
+			analytics.BestWeekday = time.Weekday(weekday)
+		}
+		if avg < analytics.WeekdayAverages[analytics.WorstWeekday] {
+			analytics.WorstWeekday = time.Weekday(weekday)
+		}
+	}
+
+	// Weekly totals, starting a new week on each Sunday
+	activeDays, activeWeeks := 0, 0
+	for i, day := range days {
+		if i == 0 || day.Date.Weekday() == time.Sunday {
+			analytics.WeeklyTotals = append(analytics.WeeklyTotals, 0)
+		}
+		analytics.WeeklyTotals[len(analytics.WeeklyTotals)-1] += day.Count
+		if day.Count > 0 {
+			activeDays++
+		}
+	}
+	for _, total := range analytics.WeeklyTotals {
+		if total > 0 {
+			activeWeeks++
+		}
+	}
+
+	// Rolling 30-day windows ending at the most recent day
+	last := days[len(days)-1].Date
+	recentStart := last.AddDate(0, 0, -30)
+	priorStart := last.AddDate(0, 0, -60)
+	recent, prior := 0, 0
+	for _, day := range days {
+		switch {
+		case day.Date.After(recentStart):
+			recent += day.Count
+		case day.Date.After(priorStart):
+			prior += day.Count
+		}
+	}
+	if prior > 0 {
+		analytics.MonthOverMonth = float64(recent-prior) / float64(prior) * 100
+		analytics.HasMonthOverMonth = true
+	}
+
+	dayShare := float64(activeDays) / float64(len(days))
+	weekShare := float64(activeWeeks) / float64(len(analytics.WeeklyTotals))
+	analytics.Consistency = int((dayShare+weekShare)/2*100 + 0.5)
+
+	return analytics
+}
+
+// sparkLevel maps value to a sparkline rune relative to max
+func sparkLevel(value, max int) rune {
+	if value <= 0 || max <= 0 {
+		return sparkRunes[0]
+	}
+	return sparkRunes[(value*len(sparkRunes)-1)/max]
+}
+
+// sparkline renders values as a single line of bar runes scaled to the largest value
+func sparkline(values []int) string {
+	max := 0
+	for _, v := range values {
+		if v > max {
+			max = v
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		b.WriteRune(sparkLevel(v, max))
+	}
+	return b.String()
+}
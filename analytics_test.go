@@ -0,0 +1,77 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// calendar builds consecutive days starting at start with the given counts
+func calendar(start time.Time, counts ...int) []Contribution {
+	days := make([]Contribution, len(counts))
+	for i, count := range counts {
+		days[i] = Contribution{Date: start.AddDate(0, 0, i), Count: count}
+	}
+	return days
+}
+
+func TestAnalyzeContributionsWeekdays(t *testing.T) {
+	sunday := time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)
+	// Two weeks: Tuesdays are busiest, Saturdays empty
+	days := calendar(sunday,
+		1, 2, 6, 2, 2, 2, 0,
+		1, 2, 4, 2, 2, 2, 0,
+	)
+
+	a := AnalyzeContributions(days)
+	if a.BestWeekday != time.Tuesday || a.WeekdayAverages[time.Tuesday] != 5 {
+		t.Errorf("best weekday = %s (%.1f), want Tuesday (5.0)", a.BestWeekday, a.WeekdayAverages[time.Tuesday])
+	}
+	if a.WorstWeekday != time.Saturday {
+		t.Errorf("worst weekday = %s, want Saturday", a.WorstWeekday)
+	}
+	if len(a.WeeklyTotals) != 2 || a.WeeklyTotals[0] != 15 || a.WeeklyTotals[1] != 13 {
+		t.Errorf("weekly totals = %v, want [15 13]", a.WeeklyTotals)
+	}
+	// 12 of 14 days active, 2 of 2 weeks active
+	if a.Consistency != 93 {
+		t.Errorf("consistency = %d, want 93", a.Consistency)
+	}
+}
+
+func TestAnalyzeContributionsMonthOverMonth(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	counts := make([]int, 60)
+	for i := range counts {
+		counts[i] = 1 // 30 in the prior window
+		if i >= 30 {
+			counts[i] = 2 // 60 in the recent window
+		}
+	}
+
+	a := AnalyzeContributions(calendar(start, counts...))
+	if !a.HasMonthOverMonth || a.MonthOverMonth != 100 {
+		t.Errorf("month over month = %.1f (%v), want +100%%", a.MonthOverMonth, a.HasMonthOverMonth)
+	}
+
+	// No earlier activity means there is nothing to compare against
+	a = AnalyzeContributions(calendar(start, 0, 0, 3))
+	if a.HasMonthOverMonth {
+		t.Errorf("expected no month-over-month change, got %.1f", a.MonthOverMonth)
+	}
+}
+
+func TestAnalyzeContributionsEmpty(t *testing.T) {
+	a := AnalyzeContributions(nil)
+	if len(a.WeeklyTotals) != 0 || a.Consistency != 0 {
+		t.Errorf("expected zero analytics, got %+v", a)
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	if got := sparkline([]int{0, 1, 4, 8}); got != "▁▁▄█" {
+		t.Errorf("sparkline = %q, want %q", got, "▁▁▄█")
+	}
+	if got := sparkline([]int{0, 0}); got != "▁▁" {
+		t.Errorf("sparkline of zeros = %q", got)
+	}
+}
@@ -9,9 +9,9 @@ import (
 )
 
 // FetchAvatarImage fetches a GitHub avatar and returns the resized image
-func FetchAvatarImage(avatarURL string, size int) (image.Image, error) {
+func FetchAvatarImage(client *http.Client, avatarURL string, size int) (image.Image, error) {
 	// Fetch the image
-	resp, err := http.Get(avatarURL)
+	resp, err := client.Get(avatarURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch avatar: %w", err)
 	}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache TTLs per endpoint family. Within the TTL a cached response is served
+// without touching the network; after it expires the request is revalidated
+// with If-None-Match / If-Modified-Since, and a 304 is served from disk.
+const (
+	cacheTTLEvents  = 1 * time.Minute
+	cacheTTLProfile = 10 * time.Minute
+	cacheTTLRepos   = 10 * time.Minute
+	cacheTTLDefault = 5 * time.Minute
+)
+
+// cacheMaxAge is how long an entry is kept after it was last stored or
+// revalidated. It is well past every TTL so entries can still be revalidated
+// with ETags across runs, while abandoned URLs don't pile up on disk.
+const cacheMaxAge = 7 * 24 * time.Hour
+
+// cacheEntry is a single cached REST response stored on disk
+type cacheEntry struct {
+	URL          string      `json:"url"`
+	ETag         string      `json:"etag"`
+	LastModified string      `json:"last_modified"`
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+	StoredAt     time.Time   `json:"stored_at"`
+}
+
+// cachingTransport is an http.RoundTripper that persists GET responses
+// keyed by URL and auth identity, and revalidates them using ETags.
+// It must sit below go-gh's auth round tripper so the Authorization header is visible.
+type cachingTransport struct {
+	base    http.RoundTripper
+	dir     string
+	refresh bool // Always revalidate, ignoring TTLs
+	now     func() time.Time
+	pruned  sync.Once
+}
+
+// newCachingTransport wraps base with an on-disk response cache in dir
+// refresh: if true, cached entries are always revalidated before use
+func newCachingTransport(base http.RoundTripper, dir string, refresh bool) *cachingTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &cachingTransport{
+		base:    base,
+		dir:     dir,
+		refresh: refresh,
+		now:     time.Now,
+	}
+}
+
+// defaultCacheDir returns the HTTP cache directory under the XDG cache dir
+func defaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gittui", "http"), nil
+}
+
+// RoundTrip serves fresh entries from disk and sends conditional requests for stale ones
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Only plain GETs are cacheable; callers doing their own conditional requests bypass the cache
+	if req.Method != http.MethodGet ||
+		req.Header.Get("If-None-Match") != "" ||
+		req.Header.Get("If-Modified-Since") != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	t.pruned.Do(t.prune)
+
+	key := t.cacheKey(req)
+	entry := t.load(key)
+
+	if entry != nil && !t.refresh && t.now().Sub(entry.StoredAt) < cacheTTLFor(req.URL.Path) {
+		return entry.response(req), nil
+	}
+
+	outReq := req
+	if entry != nil && (entry.ETag != "" || entry.LastModified != "") {
+		outReq = req.Clone(req.Context())
+		if entry.ETag != "" {
+			outReq.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			outReq.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// 304 Not Modified: serve the stored body and restart its TTL
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		resp.Body.Close()
+		for name, values := range resp.Header {
+			// Keep fresh rate-limit and poll headers from the revalidation response
+			if strings.HasPrefix(name, "X-") {
+				entry.Header[name] = values
+			}
+		}
+		entry.StoredAt = t.now()
+		t.store(key, entry)
+		return entry.response(req), nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.store(key, &cacheEntry{
+		URL:          req.URL.String(),
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		StatusCode:   resp.StatusCode,
+		Header:       resp.Header.Clone(),
+		Body:         body,
+		StoredAt:     t.now(),
+	})
+
+	return resp, nil
+}
+
+// cacheKey hashes the URL together with the auth identity so different
+// tokens (or anonymous access) never share cached private data
+func (t *cachingTransport) cacheKey(req *http.Request) string {
+	h := sha256.New()
+	h.Write([]byte(req.Header.Get("Authorization")))
+	h.Write([]byte{0})
+	h.Write([]byte(req.Header.Get("Accept")))
+	h.Write([]byte{0})
+	h.Write([]byte(req.URL.String()))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// load reads a cache entry, returning nil if missing or unreadable
+func (t *cachingTransport) load(key string) *cacheEntry {
+	data, err := os.ReadFile(filepath.Join(t.dir, key+".json"))
+	if err != nil {
+		return nil
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil
+	}
+	if entry.Header == nil {
+		entry.Header = http.Header{}
+	}
+	return &entry
+}
+
+// store writes a cache entry atomically; failures are ignored since the cache is best-effort
+func (t *cachingTransport) store(key string, entry *cacheEntry) {
+	if err := os.MkdirAll(t.dir, 0o700); err != nil {
+		return
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(t.dir, key+".*.tmp")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(t.dir, key+".json")); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// prune removes entries (and leftover temp files) older than cacheMaxAge;
+// best-effort like the rest of the cache
+func (t *cachingTransport) prune() {
+	files, err := os.ReadDir(t.dir)
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		info, err := file.Info()
+		if err != nil || info.IsDir() {
+			continue
+		}
+		if t.now().Sub(info.ModTime()) > cacheMaxAge {
+			os.Remove(filepath.Join(t.dir, file.Name()))
+		}
+	}
+}
+
+// response builds an http.Response for req from the cached entry
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	header := e.Header.Clone()
+	header.Set("X-Gittui-Cache", "hit")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// cacheTTLFor picks a TTL based on the REST endpoint path
+// Notifications are always revalidated so the inbox never starts out stale.
+func cacheTTLFor(path string) time.Duration {
+	switch {
+	case strings.HasPrefix(path, "/notifications") || strings.Contains(path, "/api/v3/notifications"):
+		return 0
+	case strings.Contains(path, "/events"):
+		return cacheTTLEvents
+	case strings.HasSuffix(path, "/repos"):
+		return cacheTTLRepos
+	case strings.HasSuffix(path, "/user") || strings.Contains(path, "/users/"):
+		return cacheTTLProfile
+	default:
+		return cacheTTLDefault
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCachingTransportRevalidatesWithETag(t *testing.T) {
+	hits := 0
+	conditional := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"login":"octocat"}`))
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	transport := newCachingTransport(http.DefaultTransport, t.TempDir(), false)
+	transport.now = func() time.Time { return now }
+	client := &http.Client{Transport: transport}
+
+	get := func() string {
+		resp, err := client.Get(server.URL + "/users/octocat")
+		if err != nil {
+			t.Fatalf("GET failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	// First request populates the cache
+	if body := get(); body != `{"login":"octocat"}` {
+		t.Fatalf("unexpected body %q", body)
+	}
+
+	// Within TTL: served from disk without a network hit
+	get()
+	if hits != 1 {
+		t.Errorf("expected fresh entry to be served from cache, got %d server hits", hits)
+	}
+
+	// After TTL: conditional request, 304 served from disk
+	now = now.Add(cacheTTLProfile + time.Second)
+	if body := get(); body != `{"login":"octocat"}` {
+		t.Errorf("unexpected revalidated body %q", body)
+	}
+	if conditional != 1 {
+		t.Errorf("expected 1 conditional request, got %d", conditional)
+	}
+}
+
+func TestCachingTransportKeysByAuthIdentity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newCachingTransport(http.DefaultTransport, t.TempDir(), false)}
+
+	for _, token := range []string{"token a", "token b"} {
+		req, _ := http.NewRequest("GET", server.URL+"/user", nil)
+		req.Header.Set("Authorization", token)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("GET failed: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != token {
+			t.Errorf("identity %q got cached body %q", token, body)
+		}
+	}
+}
+
+func TestCacheTTLForNotificationsAlwaysRevalidates(t *testing.T) {
+	for _, path := range []string{"/notifications", "/api/v3/notifications"} {
+		if ttl := cacheTTLFor(path); ttl != 0 {
+			t.Errorf("cacheTTLFor(%q) = %s, want 0", path, ttl)
+		}
+	}
+	if ttl := cacheTTLFor("/users/octocat"); ttl != cacheTTLProfile {
+		t.Errorf("profile TTL = %s, want %s", ttl, cacheTTLProfile)
+	}
+}
+
+func TestCachingTransportPrunesOldEntries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	old := filepath.Join(dir, "old.json")
+	recent := filepath.Join(dir, "recent.json")
+	for _, name := range []string{old, recent} {
+		if err := os.WriteFile(name, []byte(`{}`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Now()
+	os.Chtimes(old, now, now.Add(-cacheMaxAge-time.Hour))
+	os.Chtimes(recent, now, now.Add(-cacheMaxAge+time.Hour))
+
+	transport := newCachingTransport(http.DefaultTransport, dir, false)
+	transport.now = func() time.Time { return now }
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL + "/users/octocat")
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Error("expected entry past cacheMaxAge to be pruned")
+	}
+	if _, err := os.Stat(recent); err != nil {
+		t.Errorf("expected recent entry to be kept: %v", err)
+	}
+}
@@ -4,6 +4,7 @@ package main
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,7 +13,7 @@ import (
 
 // Constants define the graph dimensions and styling.
 const (
-	weeksToDisplay   = 52
+	weeksToDisplay   = 53 // A calendar year (or GitHub's rolling year) spans 53 week columns
 	daysPerWeek      = 7
 	cellWidth        = 2 // Character width per cell (block + space)
 	dayLabelWidth    = 4
@@ -30,24 +31,175 @@ const (
 type Contribution struct {
 	Date  time.Time
 	Count int
+	Level int // GitHub's intensity level (0 = none, 1-4 = quartiles)
+}
+
+// LevelScale selects how contribution counts map to intensity levels.
+type LevelScale string
+
+const (
+	LevelScaleGitHub   LevelScale = "github"   // GitHub's own quartiles (contributionLevel)
+	LevelScaleQuantile LevelScale = "quantile" // Quartiles of the displayed days, computed locally
+	LevelScaleFixed    LevelScale = "fixed"    // Fixed thresholds at 3/6/9 contributions
+)
+
+// ParseLevelScale validates a level scale name.
+func ParseLevelScale(name string) (LevelScale, error) {
+	switch scale := LevelScale(strings.ToLower(name)); scale {
+	case LevelScaleGitHub, LevelScaleQuantile, LevelScaleFixed:
+		return scale, nil
+	case "":
+		return LevelScaleGitHub, nil
+	default:
+		return "", fmt.Errorf("unknown contribution scale %q (want github, quantile or fixed)", name)
+	}
 }
 
 // Graph represents a contribution graph with all necessary data.
 type Graph struct {
 	contributions []Contribution
+	levels        []int // Intensity level per contribution, per the scale
 	title         string
 	showLegend    bool
+	cursor        *gridPos // Highlighted cell when the graph is focused
+}
+
+// gridPos addresses a cell in the [daysPerWeek][weeksToDisplay] grid.
+type gridPos struct {
+	day  int
+	week int
 }
 
+// Row offsets of the rendered graph: title, blank line, month labels, then day rows.
+const gridRowOffset = 3
+
 // NewGraph creates a new contribution graph from a slice of contributions.
+// Levels use GitHub's quartiles; see SetScale.
 func NewGraph(contributions []Contribution) *Graph {
-	return &Graph{
+	g := &Graph{
 		contributions: contributions,
 		title:         "Contribution Activity",
-		showLegend:    false,
+		showLegend:    true,
+	}
+	g.SetScale(LevelScaleGitHub)
+	return g
+}
+
+// SetScale recomputes intensity levels with the given scale.
+// The GitHub scale falls back to local quantiles when the data carries no
+// levels (e.g. snapshots saved before levels were recorded).
+func (g *Graph) SetScale(scale LevelScale) {
+	if scale == LevelScaleGitHub && !hasGitHubLevels(g.contributions) {
+		scale = LevelScaleQuantile
+	}
+
+	var thresholds [3]int
+	if scale == LevelScaleQuantile {
+		thresholds = quantileThresholds(g.contributions)
+	}
+
+	g.levels = make([]int, len(g.contributions))
+	for i, contrib := range g.contributions {
+		switch scale {
+		case LevelScaleGitHub:
+			g.levels[i] = contrib.Level
+		case LevelScaleQuantile:
+			g.levels[i] = quantileLevel(contrib.Count, thresholds)
+		default:
+			g.levels[i] = getContributionLevel(contrib.Count)
+		}
+	}
+}
+
+// SetTitle replaces the graph title (e.g. to show the selected year).
+func (g *Graph) SetTitle(title string) {
+	g.title = title
+}
+
+// SetCursor highlights the cell for the given day of week and week column.
+func (g *Graph) SetCursor(day, week int) {
+	g.cursor = &gridPos{day: day, week: week}
+}
+
+// ClearCursor removes the highlighted cell.
+func (g *Graph) ClearCursor() {
+	g.cursor = nil
+}
+
+// Cursor returns the highlighted cell, if any.
+func (g *Graph) Cursor() (day, week int, ok bool) {
+	if g.cursor == nil {
+		return 0, 0, false
+	}
+	return g.cursor.day, g.cursor.week, true
+}
+
+// CursorToLatest places the cursor on the most recent contribution day.
+func (g *Graph) CursorToLatest() {
+	if len(g.contributions) == 0 {
+		return
+	}
+	day, week := g.position(g.contributions[len(g.contributions)-1].Date)
+	g.SetCursor(day, week)
+}
+
+// MoveCursor moves the cursor by the given number of days and weeks,
+// staying on cells that have contribution data.
+func (g *Graph) MoveCursor(dDay, dWeek int) {
+	if g.cursor == nil {
+		g.CursorToLatest()
+		return
+	}
+	day := g.cursor.day + dDay
+	week := g.cursor.week + dWeek
+	if _, ok := g.ContributionAt(day, week); ok {
+		g.SetCursor(day, week)
 	}
 }
 
+// ContributionAt returns the contribution for a grid cell, if the cell has data.
+func (g *Graph) ContributionAt(day, week int) (Contribution, bool) {
+	if len(g.contributions) == 0 || day < 0 || day >= daysPerWeek || week < 0 || week >= weeksToDisplay {
+		return Contribution{}, false
+	}
+
+	date := g.startSunday().AddDate(0, 0, week*7+day)
+	for _, contrib := range g.contributions {
+		if contrib.Date.Equal(date) {
+			return contrib, true
+		}
+	}
+	return Contribution{}, false
+}
+
+// CellAt maps a position relative to the top-left of the rendered graph to a grid cell.
+func (g *Graph) CellAt(x, y int) (day, week int, ok bool) {
+	day = y - gridRowOffset
+	week = (x - dayLabelWidth) / cellWidth
+	if x < dayLabelWidth || day < 0 || day >= daysPerWeek {
+		return 0, 0, false
+	}
+	if _, exists := g.ContributionAt(day, week); !exists {
+		return 0, 0, false
+	}
+	return day, week, true
+}
+
+// startSunday returns the Sunday on or before the first contribution.
+func (g *Graph) startSunday() time.Time {
+	startDate := g.contributions[0].Date
+	for startDate.Weekday() != time.Sunday {
+		startDate = startDate.AddDate(0, 0, -1)
+	}
+	return startDate
+}
+
+// position returns the grid cell for a date.
+func (g *Graph) position(date time.Time) (day, week int) {
+	daysSinceStart := int(date.Sub(g.startSunday()).Hours() / 24)
+	return int(date.Weekday()), daysSinceStart / 7
+}
+
 // Render generates the complete contribution graph as a string.
 // Returns the full graph if terminal is wide enough, otherwise shows a width warning.
 func (g *Graph) Render() string {
@@ -83,20 +235,12 @@ func (g *Graph) buildGrid() [daysPerWeek][weeksToDisplay]int {
 		return grid
 	}
 
-	// Find the Sunday before the first contribution
-	startDate := g.contributions[0].Date
-	for startDate.Weekday() != time.Sunday {
-		startDate = startDate.AddDate(0, 0, -1)
-	}
-
-	// Fill grid with contribution counts
-	for _, contrib := range g.contributions {
-		daysSinceStart := int(contrib.Date.Sub(startDate).Hours() / 24)
-		week := daysSinceStart / 7
-		dayOfWeek := int(contrib.Date.Weekday())
+	// Fill grid with intensity levels
+	for i, contrib := range g.contributions {
+		dayOfWeek, week := g.position(contrib.Date)
 
 		if week >= 0 && week < weeksToDisplay && dayOfWeek >= 0 && dayOfWeek < daysPerWeek {
-			grid[dayOfWeek][week] = contrib.Count
+			grid[dayOfWeek][week] = g.levels[i]
 		}
 	}
 
@@ -121,10 +265,7 @@ func (g *Graph) renderMonthLabels() string {
 	                   "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
 
 	// Find the Sunday before the first contribution
-	startDate := g.contributions[0].Date
-	for startDate.Weekday() != time.Sunday {
-		startDate = startDate.AddDate(0, 0, -1)
-	}
+	startDate := g.startSunday()
 
 	// Build label row as character array for precise positioning
 	totalWidth := weeksToDisplay * cellWidth
@@ -176,8 +317,12 @@ func (g *Graph) renderGrid(grid [daysPerWeek][weeksToDisplay]int) string {
 
 		// Add cells for each week
 		for week := 0; week < weeksToDisplay; week++ {
-			count := grid[day][week]
-			row.WriteString(renderCell(count))
+			level := grid[day][week]
+			if g.cursor != nil && g.cursor.day == day && g.cursor.week == week {
+				row.WriteString(renderCursorCell(level))
+				continue
+			}
+			row.WriteString(renderCell(level))
 		}
 
 		rows = append(rows, row.String())
@@ -186,9 +331,8 @@ func (g *Graph) renderGrid(grid [daysPerWeek][weeksToDisplay]int) string {
 	return strings.Join(rows, "\n")
 }
 
-// renderCell creates a single contribution cell with color based on count.
-func renderCell(count int) string {
-	level := getContributionLevel(count)
+// renderCell creates a single contribution cell with color based on intensity level.
+func renderCell(level int) string {
 	color := getColorForLevel(level)
 
 	block := lipgloss.NewStyle().
@@ -198,6 +342,18 @@ func renderCell(count int) string {
 	return block + " " // Block + space for horizontal separation
 }
 
+// renderCursorCell renders the highlighted cell with an inverted background.
+func renderCursorCell(level int) string {
+	color := getColorForLevel(level)
+
+	block := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(color)).
+		Background(lipgloss.Color(CurrentTheme.Yellow)).
+		Render(blockChar)
+
+	return block + " "
+}
+
 // getContributionLevel maps a contribution count to an intensity level (0-4).
 func getContributionLevel(count int) int {
 	switch {
@@ -214,6 +370,69 @@ func getContributionLevel(count int) int {
 	}
 }
 
+// hasGitHubLevels reports whether any active day carries a GitHub contribution level.
+func hasGitHubLevels(contributions []Contribution) bool {
+	for _, contrib := range contributions {
+		if contrib.Level > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// quantileThresholds returns the upper bounds of levels 1-3: the 25th, 50th
+// and 75th percentile counts of the days with at least one contribution.
+func quantileThresholds(contributions []Contribution) [3]int {
+	var counts []int
+	for _, contrib := range contributions {
+		if contrib.Count > 0 {
+			counts = append(counts, contrib.Count)
+		}
+	}
+
+	var thresholds [3]int
+	if len(counts) == 0 {
+		return thresholds
+	}
+	sort.Ints(counts)
+	for i := range thresholds {
+		thresholds[i] = counts[(len(counts)-1)*(i+1)/4]
+	}
+	return thresholds
+}
+
+// quantileLevel maps a count to a level (0-4) using quantileThresholds.
+func quantileLevel(count int, thresholds [3]int) int {
+	if count == 0 {
+		return 0
+	}
+	for i, upper := range thresholds {
+		if count <= upper {
+			return i + 1
+		}
+	}
+	return 4
+}
+
+// levelRanges returns the smallest and largest count shown at each level.
+// Levels with no days have ok set to false.
+func (g *Graph) levelRanges() (ranges [5][2]int, ok [5]bool) {
+	for i, contrib := range g.contributions {
+		level := g.levels[i]
+		if level < 0 || level >= len(ranges) {
+			continue
+		}
+		if !ok[level] || contrib.Count < ranges[level][0] {
+			ranges[level][0] = contrib.Count
+		}
+		if !ok[level] || contrib.Count > ranges[level][1] {
+			ranges[level][1] = contrib.Count
+		}
+		ok[level] = true
+	}
+	return ranges, ok
+}
+
 // getColorForLevel returns the contribution color for a given intensity level.
 func getColorForLevel(level int) string {
 	colors := []string{
@@ -230,25 +449,32 @@ func getColorForLevel(level int) string {
 	return CurrentTheme.ContribNone
 }
 
-// renderLegend creates the "Less -> More" color scale indicator.
+// renderLegend creates the "Less -> More" color scale indicator,
+// labelling each level with the range of counts it represents.
 func (g *Graph) renderLegend() string {
-	var parts []string
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Gray))
+	ranges, ok := g.levelRanges()
 
-	parts = append(parts, lipgloss.NewStyle().
-		Foreground(lipgloss.Color(CurrentTheme.Gray)).
-		Render("Less"))
+	var parts []string
+	parts = append(parts, labelStyle.Render("Less "))
 
 	for level := 0; level < 5; level++ {
 		color := getColorForLevel(level)
 		cell := lipgloss.NewStyle().
 			Foreground(lipgloss.Color(color)).
-			Render(blockChar + " ")
-		parts = append(parts, cell)
+			Render(blockChar)
+
+		label := "–"
+		if ok[level] {
+			label = fmt.Sprintf("%d", ranges[level][0])
+			if ranges[level][1] != ranges[level][0] {
+				label = fmt.Sprintf("%d-%d", ranges[level][0], ranges[level][1])
+			}
+		}
+		parts = append(parts, cell+" "+labelStyle.Render(label)+"  ")
 	}
 
-	parts = append(parts, lipgloss.NewStyle().
-		Foreground(lipgloss.Color(CurrentTheme.Gray)).
-		Render("More"))
+	parts = append(parts, labelStyle.Render("More"))
 
 	return strings.Repeat(" ", dayLabelWidth) + strings.Join(parts, "")
 }
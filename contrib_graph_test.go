@@ -0,0 +1,107 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// twoWeeks returns 14 days of contributions starting on a Sunday
+func twoWeeks() []Contribution {
+	start := time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC) // Sunday
+	var contribs []Contribution
+	for i := 0; i < 14; i++ {
+		contribs = append(contribs, Contribution{Date: start.AddDate(0, 0, i), Count: i})
+	}
+	return contribs
+}
+
+func TestGraphCursorMovement(t *testing.T) {
+	g := NewGraph(twoWeeks())
+
+	if _, _, ok := g.Cursor(); ok {
+		t.Fatal("new graph should have no cursor")
+	}
+
+	g.CursorToLatest()
+	day, week, ok := g.Cursor()
+	if !ok || day != 6 || week != 1 {
+		t.Fatalf("CursorToLatest = (%d, %d, %v), want (6, 1, true)", day, week, ok)
+	}
+
+	// Moving past the last day with data is a no-op
+	g.MoveCursor(0, 1)
+	if d, w, _ := g.Cursor(); d != 6 || w != 1 {
+		t.Errorf("cursor moved off the data to (%d, %d)", d, w)
+	}
+
+	g.MoveCursor(-2, -1)
+	day, week, _ = g.Cursor()
+	contrib, ok := g.ContributionAt(day, week)
+	if !ok || contrib.Count != 4 {
+		t.Errorf("expected Thursday of week 0 (count 4), got %+v", contrib)
+	}
+
+	g.ClearCursor()
+	if _, _, ok := g.Cursor(); ok {
+		t.Error("ClearCursor should remove the cursor")
+	}
+}
+
+func TestGraphCellAt(t *testing.T) {
+	g := NewGraph(twoWeeks())
+
+	day, week, ok := g.CellAt(dayLabelWidth+cellWidth, gridRowOffset+3)
+	if !ok || day != 3 || week != 1 {
+		t.Errorf("CellAt = (%d, %d, %v), want (3, 1, true)", day, week, ok)
+	}
+
+	// Day labels, rows above the grid, and weeks without data are not cells
+	for _, pos := range [][2]int{{0, gridRowOffset}, {dayLabelWidth, 0}, {dayLabelWidth + 5*cellWidth, gridRowOffset}} {
+		if _, _, ok := g.CellAt(pos[0], pos[1]); ok {
+			t.Errorf("CellAt(%d, %d) should miss", pos[0], pos[1])
+		}
+	}
+}
+
+func TestGraphLevelScales(t *testing.T) {
+	days := []Contribution{
+		{Date: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC), Count: 0, Level: 0},
+		{Date: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), Count: 1, Level: 1},
+		{Date: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), Count: 20, Level: 2},
+		{Date: time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC), Count: 40, Level: 3},
+		{Date: time.Date(2025, 3, 6, 0, 0, 0, 0, time.UTC), Count: 80, Level: 4},
+	}
+
+	tests := []struct {
+		scale LevelScale
+		want  []int
+	}{
+		{LevelScaleGitHub, []int{0, 1, 2, 3, 4}},
+		{LevelScaleQuantile, []int{0, 1, 2, 3, 4}},
+		{LevelScaleFixed, []int{0, 1, 4, 4, 4}},
+	}
+
+	for _, tt := range tests {
+		g := NewGraph(days)
+		g.SetScale(tt.scale)
+		for i, want := range tt.want {
+			if g.levels[i] != want {
+				t.Errorf("%s: level of %d = %d, want %d", tt.scale, days[i].Count, g.levels[i], want)
+			}
+		}
+	}
+
+	// Without GitHub levels (old snapshots) the GitHub scale falls back to quantiles
+	for i := range days {
+		days[i].Level = 0
+	}
+	g := NewGraph(days)
+	if g.levels[4] != 4 {
+		t.Errorf("expected quantile fallback, got levels %v", g.levels)
+	}
+
+	ranges, ok := g.levelRanges()
+	if !ok[0] || !ok[4] || ranges[4] != [2]int{80, 80} {
+		t.Errorf("unexpected level ranges %v %v", ranges, ok)
+	}
+}
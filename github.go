@@ -4,23 +4,59 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"image"
 	"io"
 	"net/http"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
-	"github.com/cli/go-gh/v2/pkg/auth"
 	ghAPI "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/auth"
 )
 
 const (
+	defaultHost      = "github.com"
 	githubAPIURL     = "https://api.github.com"
 	githubGraphQLURL = "https://api.github.com/graphql"
 )
 
+// clientTimeout bounds each attempt of an API request; rate limit backoff
+// between attempts isn't counted against it
+const clientTimeout = 10 * time.Second
+
+// Events API limits: 300 events from the last 90 days, at most 10 pages
+const (
+	eventsPerPage = 100
+	maxEventPages = 10
+)
+
+// apiURLsForHost returns the REST base and GraphQL endpoint for a host
+// github.com uses api.github.com; GitHub Enterprise Server uses /api/v3 and /api/graphql
+func apiURLsForHost(host string) (apiURL, graphqlURL string) {
+	if host == "" || host == defaultHost {
+		return githubAPIURL, githubGraphQLURL
+	}
+	return fmt.Sprintf("https://%s/api/v3", host), fmt.Sprintf("https://%s/api/graphql", host)
+}
+
 // GitHubClient handles all GitHub API interactions
+// It is the production ProfileSource implementation
 type GitHubClient struct {
 	httpClient *http.Client
+	host       string // github.com or a GitHub Enterprise Server hostname
+	apiURL     string // REST API base URL
+	graphqlURL string // GraphQL endpoint URL
+	rateLimits *RateLimitTracker
+}
+
+// ClientConfig holds command-line options that affect how the client talks to GitHub
+type ClientConfig struct {
+	Host    string // github.com or a GitHub Enterprise Server hostname (--hostname / GITTUI_HOST)
+	NoCache bool   // Disable the on-disk response cache entirely
+	Refresh bool   // Revalidate every cached response, ignoring TTLs
 }
 
 // NewGitHubClient creates a new GitHub API client using official go-gh library
@@ -29,16 +65,33 @@ type GitHubClient struct {
 // - gh CLI authentication
 // - Proper token storage and security
 // - No manual exec.Command calls
-func NewGitHubClient() (*GitHubClient, error) {
+func NewGitHubClient(cfg ClientConfig) (*GitHubClient, error) {
 	// Use official go-gh to get authenticated HTTP client
 	// This automatically handles:
 	// 1. Checking GITHUB_TOKEN/GH_TOKEN env vars
 	// 2. Using gh CLI auth if available
 	// 3. Proper OAuth token handling
 	// 4. Security best practices
+	host := cfg.Host
+	if host == "" {
+		host = defaultHost
+	}
+
+	// No client-wide timeout: rateLimitTransport times out each attempt so
+	// that waiting out a secondary rate limit doesn't abort the request
 	opts := &ghAPI.ClientOptions{
-		Host:    "github.com",
-		Timeout: 10 * time.Second,
+		Host: host,
+	}
+
+	// Rate-limit tracking and the ETag cache sit at the bottom of go-gh's
+	// transport chain so they see the auth header. The cache wraps the tracker
+	// so only real network responses (including 304s) update quota.
+	rateLimits := NewRateLimitTracker()
+	opts.Transport = newRateLimitTransport(http.DefaultTransport, rateLimits)
+	if !cfg.NoCache {
+		if dir, err := defaultCacheDir(); err == nil {
+			opts.Transport = newCachingTransport(opts.Transport, dir, cfg.Refresh)
+		}
 	}
 
 	httpClient, err := ghAPI.NewHTTPClient(*opts)
@@ -47,24 +100,69 @@ func NewGitHubClient() (*GitHubClient, error) {
 	}
 
 	// Verify authentication works
-	if token, _ := auth.TokenForHost("github.com"); token == "" {
-		return nil, fmt.Errorf("no GitHub authentication found\nRun 'gh auth login' or set GITHUB_TOKEN environment variable")
+	if token, _ := auth.TokenForHost(host); token == "" {
+		return nil, fmt.Errorf("no GitHub authentication found for %s\nRun 'gh auth login --hostname %s' or set GH_ENTERPRISE_TOKEN/GITHUB_TOKEN environment variable", host, host)
 	}
 
+	apiURL, graphqlURL := apiURLsForHost(host)
+	client := NewGitHubClientWithHTTP(httpClient, apiURL, graphqlURL)
+	client.host = host
+	client.rateLimits = rateLimits
+	return client, nil
+}
+
+// NewGitHubClientWithHTTP creates a client against arbitrary REST and GraphQL
+// endpoints using the given HTTP client (e.g. a local stand-in server in tests)
+func NewGitHubClientWithHTTP(httpClient *http.Client, apiURL, graphqlURL string) *GitHubClient {
 	return &GitHubClient{
 		httpClient: httpClient,
-	}, nil
+		host:       defaultHost,
+		apiURL:     apiURL,
+		graphqlURL: graphqlURL,
+		rateLimits: NewRateLimitTracker(),
+	}
+}
+
+// Host returns the GitHub host the client talks to
+func (c *GitHubClient) Host() string {
+	return c.host
+}
+
+// FetchAvatar fetches and resizes an avatar image
+// Uses the authenticated client since GHES avatars may require auth; go-gh only
+// sends the token to the configured host, so github.com avatar CDNs never see it.
+func (c *GitHubClient) FetchAvatar(avatarURL string, size int) (image.Image, error) {
+	return FetchAvatarImage(c.httpClient, avatarURL, size)
+}
+
+// RateLimits returns the quota tracker fed by every API response
+func (c *GitHubClient) RateLimits() *RateLimitTracker {
+	return c.rateLimits
 }
 
 // Repository represents a GitHub repository
 type Repository struct {
-	Name        string `json:"name"`
-	FullName    string `json:"full_name"`
-	Description string `json:"description"`
-	Language    string `json:"language"`
-	Stars       int    `json:"stargazers_count"`
-	Forks       int    `json:"forks_count"`
-	Private     bool   `json:"private"`
+	Name        string          `json:"name"`
+	FullName    string          `json:"full_name"`
+	Description string          `json:"description"`
+	HTMLURL     string          `json:"html_url"`
+	Language    string          `json:"language"`
+	Stars       int             `json:"stargazers_count"`
+	Forks       int             `json:"forks_count"`
+	OpenIssues  int             `json:"open_issues_count"`
+	Size        int             `json:"size"` // Kilobytes
+	Private     bool            `json:"private"`
+	Fork        bool            `json:"fork"`
+	Archived    bool            `json:"archived"`
+	Topics      []string        `json:"topics"`
+	Owner       RepositoryOwner `json:"owner"`
+	PushedAt    time.Time       `json:"pushed_at"`
+}
+
+// RepositoryOwner is the user or organization owning a repository
+type RepositoryOwner struct {
+	Login string `json:"login"`
+	Type  string `json:"type"`
 }
 
 // ProfileData contains user profile information
@@ -74,12 +172,15 @@ type ProfileData struct {
 	Bio         string
 	Location    string
 	Company     string
+	Blog        string // Website (organizations only)
 	AvatarURL   string
 	PublicRepos int
 	PublicGists int
 	Followers   int
 	Following   int
 	CreatedAt   time.Time
+	IsOrg       bool     // Organization profile (gittui org <name>)
+	Members     []string // Public member logins (organizations only)
 }
 
 // LanguageStats represents language usage statistics
@@ -87,24 +188,79 @@ type LanguageStats struct {
 	Name       string
 	Percentage float64
 	Color      string
+	Repos      int   // Repositories using the language (repo-count mode)
+	Bytes      int64 // Bytes of code in the language (bytes mode)
 }
 
 // Activity represents a recent activity item
 type Activity struct {
+	ID        string
 	Type      string
 	Repo      string
-	Action    string
+	Action    string       // Human-readable description, e.g. "Merged PR #42: fix auth"
+	Details   EventDetails // Decoded event payload
 	Timestamp time.Time
 	Public    bool
 }
 
+// EventDetails is the decoded payload of an activity event
+// Fields are only set for the event types that carry them
+type EventDetails struct {
+	Action      string        `json:"action,omitempty"`       // Payload action: opened, closed, published, ...
+	Ref         string        `json:"ref,omitempty"`          // Branch or tag name without refs/heads/ or refs/tags/
+	RefType     string        `json:"ref_type,omitempty"`     // repository, branch or tag (Create/DeleteEvent)
+	Number      int           `json:"number,omitempty"`       // Pull request or issue number
+	Title       string        `json:"title,omitempty"`        // Pull request, issue or wiki page title, or release name
+	IsPR        bool          `json:"is_pr,omitempty"`        // Issue comments on pull requests
+	Merged      bool          `json:"merged,omitempty"`       // Pull request closed by merging
+	ReviewState string        `json:"review_state,omitempty"` // approved, changes_requested or commented
+	Body        string        `json:"body,omitempty"`         // Pull request or issue body excerpt
+	Commits     []EventCommit `json:"commits,omitempty"`      // Pushed commits, oldest first
+	CommitCount int           `json:"commit_count,omitempty"` // Commits pushed (may exceed len(Commits))
+	CommitID    string        `json:"commit_id,omitempty"`    // Commented commit SHA
+	Tag         string        `json:"tag,omitempty"`          // Release tag
+	Pages       int           `json:"pages,omitempty"`        // Wiki pages edited
+	Member      string        `json:"member,omitempty"`       // Collaborator login (MemberEvent)
+	Fork        string        `json:"fork,omitempty"`         // Full name of the created fork
+	URL         string        `json:"url,omitempty"`          // Web URL of the PR, issue, comment, release, ...
+}
+
+// EventCommit is a commit listed in a PushEvent
+type EventCommit struct {
+	SHA     string `json:"sha"`
+	Message string `json:"message"` // Headline only
+	Author  string `json:"author"`
+}
+
+// maxBodyExcerpt is how much of a PR or issue body is kept for expanded rows
+const maxBodyExcerpt = 280
+
+// WebURL returns the page to open for an activity on host
+// Falls back from the payload's own URL to the commit, branch or repository page
+func (a Activity) WebURL(host string) string {
+	if a.Details.URL != "" {
+		return a.Details.URL
+	}
+	if host == "" {
+		host = defaultHost
+	}
+	repoURL := fmt.Sprintf("https://%s/%s", host, a.Repo)
+	switch {
+	case len(a.Details.Commits) == 1:
+		return repoURL + "/commit/" + a.Details.Commits[0].SHA
+	case a.Details.Ref != "" && a.Type != "DeleteEvent":
+		return repoURL + "/tree/" + a.Details.Ref
+	default:
+		return repoURL
+	}
+}
 
 // FetchProfile fetches user profile data
 // includePrivate: if true, uses /user endpoint to get private counts (only works for authenticated user)
 func (c *GitHubClient) FetchProfile(username string, includePrivate bool) (*ProfileData, error) {
-	url := fmt.Sprintf("%s/users/%s", githubAPIURL, username)
+	url := fmt.Sprintf("%s/users/%s", c.apiURL, username)
 	if includePrivate {
-		url = fmt.Sprintf("%s/user", githubAPIURL)
+		url = fmt.Sprintf("%s/user", c.apiURL)
 	}
 
 	req, err := http.NewRequest("GET", url, nil)
@@ -121,7 +277,7 @@ func (c *GitHubClient) FetchProfile(username string, includePrivate bool) (*Prof
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GitHub API error: %s", resp.Status)
+		return nil, apiError(resp)
 	}
 
 	var data struct {
@@ -160,7 +316,7 @@ func (c *GitHubClient) FetchProfile(username string, includePrivate bool) (*Prof
 
 // FetchAuthenticatedUser fetches the authenticated user's profile
 func (c *GitHubClient) FetchAuthenticatedUser() (*ProfileData, error) {
-	url := fmt.Sprintf("%s/user", githubAPIURL)
+	url := fmt.Sprintf("%s/user", c.apiURL)
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -176,7 +332,7 @@ func (c *GitHubClient) FetchAuthenticatedUser() (*ProfileData, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("GitHub API error: %s", resp.Status)
+		return nil, apiError(resp)
 	}
 
 	var data struct {
@@ -214,163 +370,327 @@ func (c *GitHubClient) FetchAuthenticatedUser() (*ProfileData, error) {
 	return profile, nil
 }
 
+// ContributionCalendar is a user's contribution calendar for one period
+type ContributionCalendar struct {
+	Year    int                 // Calendar year shown, or 0 for the last 12 months
+	Days    []Contribution      // One entry per day, oldest first
+	Years   []int               // Years the user has contributions in, most recent first
+	Totals  *ContributionTotals // Contribution counts by kind for the period
+	Members int                 // Member calendars summed into an organization calendar, 0 for users
+	Skipped int                 // Member calendars that failed to load and were left out
+}
+
+// ContributionTotals are contributionsCollection totals for a period
+type ContributionTotals struct {
+	Commits      int       `json:"commits"`
+	PullRequests int       `json:"pull_requests"`
+	Reviews      int       `json:"reviews"`
+	Issues       int       `json:"issues"`
+	StartedAt    time.Time `json:"started_at"`
+	EndedAt      time.Time `json:"ended_at"`
+}
+
+// Days returns the length of the period in days, excluding any future part
+func (t *ContributionTotals) Days() float64 {
+	end := t.EndedAt
+	if now := time.Now(); end.After(now) {
+		end = now
+	}
+	return end.Sub(t.StartedAt).Hours() / 24
+}
+
 // FetchContributions fetches contribution calendar data using GraphQL
-func (c *GitHubClient) FetchContributions(username string) ([]Contribution, error) {
+// year: calendar year to fetch, or 0 for GitHub's default last-12-months window
+func (c *GitHubClient) FetchContributions(username string, year int) (*ContributionCalendar, error) {
 	query := `
-	query($username: String!) {
+	query($username: String!, $from: DateTime, $to: DateTime) {
 		user(login: $username) {
-			contributionsCollection {
+			contributionsCollection(from: $from, to: $to) {
+				contributionYears
+				startedAt
+				endedAt
+				totalCommitContributions
+				totalPullRequestContributions
+				totalPullRequestReviewContributions
+				totalIssueContributions
 				contributionCalendar {
 					weeks {
 						contributionDays {
 							contributionCount
+							contributionLevel
 							date
 						}
 					}
 				}
 			}
 		}
+		rateLimit {
+			limit
+			cost
+			remaining
+			resetAt
+		}
 	}`
 
 	variables := map[string]interface{}{
 		"username": username,
+		"from":     nil,
+		"to":       nil,
 	}
-
-	reqBody := map[string]interface{}{
-		"query":     query,
-		"variables": variables,
-	}
-
-	jsonData, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", githubGraphQLURL, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, err
+	if year != 0 {
+		variables["from"] = time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).Format(time.RFC3339)
+		variables["to"] = time.Date(year, time.December, 31, 23, 59, 59, 0, time.UTC).Format(time.RFC3339)
 	}
-	// Authorization header automatically added by go-gh HTTPClient
-	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+	var data struct {
+		User struct {
+			ContributionsCollection struct {
+				ContributionYears                   []int     `json:"contributionYears"`
+				StartedAt                           time.Time `json:"startedAt"`
+				EndedAt                             time.Time `json:"endedAt"`
+				TotalCommitContributions            int       `json:"totalCommitContributions"`
+				TotalPullRequestContributions       int       `json:"totalPullRequestContributions"`
+				TotalPullRequestReviewContributions int       `json:"totalPullRequestReviewContributions"`
+				TotalIssueContributions             int       `json:"totalIssueContributions"`
+				ContributionCalendar                struct {
+					Weeks []struct {
+						ContributionDays []struct {
+							ContributionCount int    `json:"contributionCount"`
+							ContributionLevel string `json:"contributionLevel"`
+							Date              string `json:"date"`
+						} `json:"contributionDays"`
+					} `json:"weeks"`
+				} `json:"contributionCalendar"`
+			} `json:"contributionsCollection"`
+		} `json:"user"`
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
+	if err := c.graphQL(query, variables, &data); err != nil {
 		return nil, err
 	}
 
-	var result struct {
-		Data struct {
-			User struct {
-				ContributionsCollection struct {
-					ContributionCalendar struct {
-						Weeks []struct {
-							ContributionDays []struct {
-								ContributionCount int    `json:"contributionCount"`
-								Date              string `json:"date"`
-							} `json:"contributionDays"`
-						} `json:"weeks"`
-					} `json:"contributionCalendar"`
-				} `json:"contributionsCollection"`
-			} `json:"user"`
-		} `json:"data"`
-	}
-
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, err
+	collection := data.User.ContributionsCollection
+	calendar := &ContributionCalendar{
+		Year:  year,
+		Years: collection.ContributionYears,
+		Totals: &ContributionTotals{
+			Commits:      collection.TotalCommitContributions,
+			PullRequests: collection.TotalPullRequestContributions,
+			Reviews:      collection.TotalPullRequestReviewContributions,
+			Issues:       collection.TotalIssueContributions,
+			StartedAt:    collection.StartedAt,
+			EndedAt:      collection.EndedAt,
+		},
 	}
-
-	var contributions []Contribution
-	for _, week := range result.Data.User.ContributionsCollection.ContributionCalendar.Weeks {
+	for _, week := range collection.ContributionCalendar.Weeks {
 		for _, day := range week.ContributionDays {
 			date, err := time.Parse("2006-01-02", day.Date)
 			if err != nil {
 				continue
 			}
-			contributions = append(contributions, Contribution{
+			calendar.Days = append(calendar.Days, Contribution{
 				Date:  date,
 				Count: day.ContributionCount,
+				Level: contributionLevelValue(day.ContributionLevel),
 			})
 		}
 	}
 
-	return contributions, nil
+	return calendar, nil
 }
 
-// FetchLanguages fetches top language statistics and total repo count
-// includePrivate: if true, uses /user/repos with affiliation to get all repos including org repos
-func (c *GitHubClient) FetchLanguages(username string, includePrivate bool) ([]LanguageStats, int, error) {
-	var baseURL string
+// contributionLevelValue converts a GraphQL ContributionLevel enum to 0-4
+func contributionLevelValue(level string) int {
+	switch level {
+	case "FIRST_QUARTILE":
+		return 1
+	case "SECOND_QUARTILE":
+		return 2
+	case "THIRD_QUARTILE":
+		return 3
+	case "FOURTH_QUARTILE":
+		return 4
+	default:
+		return 0
+	}
+}
+
+// DayContributions is the breakdown of a single day's contributions
+type DayContributions struct {
+	Date    time.Time
+	Commits int
+	PRs     int
+	Issues  int
+	Reviews int
+	Repos   []RepoContributions // Commit counts per repository, most active first
+}
+
+// RepoContributions counts commits made to one repository
+type RepoContributions struct {
+	Repo    string
+	Commits int
+}
+
+// FetchContributionDay fetches the commit/PR/issue/review breakdown for a single day
+func (c *GitHubClient) FetchContributionDay(username string, date time.Time) (*DayContributions, error) {
+	query := `
+	query($username: String!, $from: DateTime!, $to: DateTime!) {
+		user(login: $username) {
+			contributionsCollection(from: $from, to: $to) {
+				totalCommitContributions
+				totalPullRequestContributions
+				totalIssueContributions
+				totalPullRequestReviewContributions
+				commitContributionsByRepository(maxRepositories: 5) {
+					repository {
+						nameWithOwner
+					}
+					contributions {
+						totalCount
+					}
+				}
+			}
+		}
+		rateLimit {
+			limit
+			cost
+			remaining
+			resetAt
+		}
+	}`
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	variables := map[string]interface{}{
+		"username": username,
+		"from":     day.Format(time.RFC3339),
+		"to":       day.Add(24*time.Hour - time.Second).Format(time.RFC3339),
+	}
 
+	var data struct {
+		User struct {
+			ContributionsCollection struct {
+				TotalCommitContributions            int `json:"totalCommitContributions"`
+				TotalPullRequestContributions       int `json:"totalPullRequestContributions"`
+				TotalIssueContributions             int `json:"totalIssueContributions"`
+				TotalPullRequestReviewContributions int `json:"totalPullRequestReviewContributions"`
+				CommitContributionsByRepository     []struct {
+					Repository struct {
+						NameWithOwner string `json:"nameWithOwner"`
+					} `json:"repository"`
+					Contributions struct {
+						TotalCount int `json:"totalCount"`
+					} `json:"contributions"`
+				} `json:"commitContributionsByRepository"`
+			} `json:"contributionsCollection"`
+		} `json:"user"`
+	}
+
+	if err := c.graphQL(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	collection := data.User.ContributionsCollection
+	detail := &DayContributions{
+		Date:    day,
+		Commits: collection.TotalCommitContributions,
+		PRs:     collection.TotalPullRequestContributions,
+		Issues:  collection.TotalIssueContributions,
+		Reviews: collection.TotalPullRequestReviewContributions,
+	}
+	for _, repo := range collection.CommitContributionsByRepository {
+		detail.Repos = append(detail.Repos, RepoContributions{
+			Repo:    repo.Repository.NameWithOwner,
+			Commits: repo.Contributions.TotalCount,
+		})
+	}
+
+	return detail, nil
+}
+
+// FetchAllRepositories fetches every repository visible for the user in a single paginated pass
+// includePrivate: if true, uses /user/repos with affiliation to get all repos including org repos
+// Languages and top repositories are derived from the result in memory.
+func (c *GitHubClient) FetchAllRepositories(username string, includePrivate bool) ([]Repository, error) {
+	url := fmt.Sprintf("%s/users/%s/repos?per_page=100", c.apiURL, username)
 	if includePrivate {
-		baseURL = fmt.Sprintf("%s/user/repos?per_page=100&affiliation=owner,collaborator,organization_member", githubAPIURL)
-	} else {
-		baseURL = fmt.Sprintf("%s/users/%s/repos?per_page=100", githubAPIURL, username)
+		url = fmt.Sprintf("%s/user/repos?per_page=100&affiliation=owner,collaborator,organization_member", c.apiURL)
 	}
 
-	// Fetch all repos with pagination
-	var allRepos []struct {
-		Name     string `json:"name"`
-		Language string `json:"language"`
-		Fork     bool   `json:"fork"`
-		Private  bool   `json:"private"`
+	var allRepos []Repository
+	err := c.fetchPages(url, 0, func(body io.Reader) error {
+		var repos []Repository
+		if err := json.NewDecoder(body).Decode(&repos); err != nil {
+			return err
+		}
+		allRepos = append(allRepos, repos...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	page := 1
-	for {
-		url := fmt.Sprintf("%s&page=%d", baseURL, page)
+	return allRepos, nil
+}
+
+// fetchPages GETs url and follows rel="next" Link headers, handing each page body to decode
+// maxPages: stop after this many pages (0 = no limit)
+func (c *GitHubClient) fetchPages(url string, maxPages int, decode func(io.Reader) error) error {
+	for page := 1; url != ""; page++ {
+		if maxPages > 0 && page > maxPages {
+			break
+		}
 
 		req, err := http.NewRequest("GET", url, nil)
 		if err != nil {
-			return nil, 0, err
+			return err
 		}
 		// Authorization header automatically added by go-gh HTTPClient
 		req.Header.Set("Accept", "application/vnd.github.v3+json")
 
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
-			return nil, 0, err
-		}
-
-		var repos []struct {
-			Name     string `json:"name"`
-			Language string `json:"language"`
-			Fork     bool   `json:"fork"`
-			Private  bool   `json:"private"`
+			return err
 		}
-
-		if err := json.NewDecoder(resp.Body).Decode(&repos); err != nil {
+		if resp.StatusCode != http.StatusOK {
 			resp.Body.Close()
-			return nil, 0, err
+			return apiError(resp)
 		}
-		resp.Body.Close()
 
-		if len(repos) == 0 {
-			break
+		err = decode(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
 		}
 
-		allRepos = append(allRepos, repos...)
+		url = nextPageURL(resp.Header.Get("Link"))
+	}
 
-		// If we got less than 100, we're done
-		if len(repos) < 100 {
-			break
-		}
+	return nil
+}
 
-		page++
+// nextPageURL extracts the rel="next" URL from a Link header, or "" on the last page
+func nextPageURL(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		segments := strings.Split(part, ";")
+		if len(segments) < 2 {
+			continue
+		}
+		for _, param := range segments[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(segments[0]), "<>")
+			}
+		}
 	}
+	return ""
+}
 
-	// Total repo count
-	totalRepoCount := len(allRepos)
-
+// LanguageStatsFromRepositories computes the top 5 languages by repo count (excluding forks)
+// Returns the languages and the total repo count
+func LanguageStatsFromRepositories(repos []Repository) ([]LanguageStats, int) {
 	// Count languages (excluding forks)
 	langCount := make(map[string]int)
 	total := 0
-	for _, repo := range allRepos {
+	for _, repo := range repos {
 		if !repo.Fork && repo.Language != "" {
 			langCount[repo.Language]++
 			total++
@@ -384,192 +704,1510 @@ func (c *GitHubClient) FetchLanguages(username string, includePrivate bool) ([]L
 			Name:       lang,
 			Percentage: float64(count) / float64(total),
 			Color:      getLanguageColor(lang),
+			Repos:      count,
 		})
 	}
 
-	// Sort by percentage (descending)
-	for i := 0; i < len(languages); i++ {
-		for j := i + 1; j < len(languages); j++ {
-			if languages[j].Percentage > languages[i].Percentage {
-				languages[i], languages[j] = languages[j], languages[i]
-			}
+	// Sort by percentage (descending), then name for stable output
+	sort.Slice(languages, func(i, j int) bool {
+		if languages[i].Percentage != languages[j].Percentage {
+			return languages[i].Percentage > languages[j].Percentage
 		}
-	}
+		return languages[i].Name < languages[j].Name
+	})
 
 	// Return top 5
 	if len(languages) > 5 {
 		languages = languages[:5]
 	}
 
-	return languages, totalRepoCount, nil
+	return languages, len(repos)
 }
 
-// FetchTopRepositories fetches user's top repositories sorted by stars
-func (c *GitHubClient) FetchTopRepositories(username string, includePrivate bool) ([]Repository, error) {
-	var baseURL string
+// FetchLanguageBytes fetches the language breakdown by bytes of code using GraphQL
+// Aggregates languages { edges { size node { name color } } } across all owned non-fork repos
+// username may be a user or an organization
+// includePrivate: if true, private repos are included (own profile only)
+func (c *GitHubClient) FetchLanguageBytes(username string, includePrivate bool) ([]LanguageStats, error) {
+	query := `
+	query($username: String!, $privacy: RepositoryPrivacy, $cursor: String) {
+		repositoryOwner(login: $username) {
+			repositories(first: 100, after: $cursor, ownerAffiliations: OWNER, isFork: false, privacy: $privacy) {
+				nodes {
+					languages(first: 20, orderBy: {field: SIZE, direction: DESC}) {
+						edges {
+							size
+							node {
+								name
+								color
+							}
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+		rateLimit {
+			limit
+			cost
+			remaining
+			resetAt
+		}
+	}`
 
-	if includePrivate {
-		baseURL = fmt.Sprintf("%s/user/repos?per_page=100&affiliation=owner,collaborator,organization_member", githubAPIURL)
-	} else {
-		baseURL = fmt.Sprintf("%s/users/%s/repos?per_page=100", githubAPIURL, username)
+	variables := map[string]interface{}{
+		"username": username,
+		"privacy":  nil,
+		"cursor":   nil,
+	}
+	if !includePrivate {
+		variables["privacy"] = "PUBLIC"
 	}
 
-	// Fetch all repos with pagination
-	var allRepos []Repository
-	page := 1
+	langBytes := make(map[string]int64)
+	langColor := make(map[string]string)
+	var total int64
 
 	for {
-		url := fmt.Sprintf("%s&page=%d", baseURL, page)
+		var data struct {
+			Owner struct {
+				Repositories struct {
+					Nodes []struct {
+						Languages struct {
+							Edges []struct {
+								Size int64 `json:"size"`
+								Node struct {
+									Name  string `json:"name"`
+									Color string `json:"color"`
+								} `json:"node"`
+							} `json:"edges"`
+						} `json:"languages"`
+					} `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"repositories"`
+			} `json:"repositoryOwner"`
+		}
 
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
+		if err := c.graphQL(query, variables, &data); err != nil {
 			return nil, err
 		}
-		// Authorization header automatically added by go-gh HTTPClient
-		req.Header.Set("Accept", "application/vnd.github.v3+json")
 
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			return nil, err
+		for _, repo := range data.Owner.Repositories.Nodes {
+			for _, edge := range repo.Languages.Edges {
+				langBytes[edge.Node.Name] += edge.Size
+				total += edge.Size
+				if edge.Node.Color != "" {
+					langColor[edge.Node.Name] = edge.Node.Color
+				}
+			}
 		}
 
-		var repos []Repository
-		if err := json.NewDecoder(resp.Body).Decode(&repos); err != nil {
-			resp.Body.Close()
-			return nil, err
+		pageInfo := data.Owner.Repositories.PageInfo
+		if !pageInfo.HasNextPage {
+			break
 		}
-		resp.Body.Close()
+		variables["cursor"] = pageInfo.EndCursor
+	}
 
-		allRepos = append(allRepos, repos...)
+	var languages []LanguageStats
+	for lang, size := range langBytes {
+		color, ok := langColor[lang]
+		if !ok {
+			color = getLanguageColor(lang)
+		}
+		languages = append(languages, LanguageStats{
+			Name:       lang,
+			Percentage: float64(size) / float64(total),
+			Color:      color,
+			Bytes:      size,
+		})
+	}
 
-		// If we got less than 100 repos, we've reached the last page
-		if len(repos) < 100 {
-			break
+	// Sort by bytes (descending), then name for stable output
+	sort.Slice(languages, func(i, j int) bool {
+		if languages[i].Bytes != languages[j].Bytes {
+			return languages[i].Bytes > languages[j].Bytes
 		}
-		page++
+		return languages[i].Name < languages[j].Name
+	})
+
+	// Return top 5
+	if len(languages) > 5 {
+		languages = languages[:5]
 	}
 
-	// Sort by stars (stargazers_count) - GitHub API doesn't support server-side sorting by stars
-	sort.Slice(allRepos, func(i, j int) bool {
-		return allRepos[i].Stars > allRepos[j].Stars
+	return languages, nil
+}
+
+// TopRepositories returns the n repositories with the most stars
+func TopRepositories(repos []Repository, n int) []Repository {
+	// Sort a copy by stars - GitHub API doesn't support server-side sorting by stars
+	sorted := make([]Repository, len(repos))
+	copy(sorted, repos)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Stars > sorted[j].Stars
 	})
 
-	// Return top 5 repos
-	if len(allRepos) > 5 {
-		allRepos = allRepos[:5]
+	if len(sorted) > n {
+		sorted = sorted[:n]
 	}
 
-	return allRepos, nil
+	return sorted
 }
 
-// FetchRecentActivity fetches recent user activity
-// includePrivate: if true, uses /users/{username}/events to get private events (only works for authenticated user)
-func (c *GitHubClient) FetchRecentActivity(username string, includePrivate bool) ([]Activity, error) {
-	var url string
+// RepoDetail is everything shown on a repository's detail page
+type RepoDetail struct {
+	FullName      string
+	Description   string
+	HTMLURL       string
+	Homepage      string
+	Stars         int
+	Forks         int
+	Watchers      int
+	OpenPRs       int
+	OpenIssues    int // Issues only, unlike Repository.OpenIssues which counts PRs too
+	Private       bool
+	Fork          bool
+	Archived      bool
+	PushedAt      time.Time
+	License       string // SPDX ID, or the license name when GitHub has no ID for it
+	Topics        []string
+	LatestRelease *Release // nil when the repository has no releases
+	Languages     []LanguageStats
+	Readme        string // Raw markdown, "" when the repository has no README
+	CommitWeeks   []int  // Commits per week for the last year, oldest first
+	CommitsQueued bool   // GitHub is still computing commit activity; retry later
+	Contributors  []Contributor
+
+	// The README, commit activity and contributors are optional sections;
+	// a failure is shown in its section instead of failing the page
+	ReadmeErr       error
+	CommitsErr      error
+	ContributorsErr error
+}
 
-	if includePrivate {
-		url = fmt.Sprintf("%s/users/%s/events?per_page=30", githubAPIURL, username)
-	} else {
-		url = fmt.Sprintf("%s/users/%s/events/public?per_page=20", githubAPIURL, username)
+// Release is a published repository release
+type Release struct {
+	Name        string
+	Tag         string
+	URL         string
+	PublishedAt time.Time
+}
+
+// Contributor is a repository contributor and their commit count
+type Contributor struct {
+	Login         string `json:"login"`
+	Contributions int    `json:"contributions"`
+}
+
+// maxContributors is how many contributors the detail page lists
+const maxContributors = 10
+
+// FetchRepoDetail fetches metadata, counts, README, commit activity and
+// contributors for the repository fullName (owner/name)
+func (c *GitHubClient) FetchRepoDetail(fullName string) (*RepoDetail, error) {
+	owner, name, ok := strings.Cut(fullName, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repository name %q", fullName)
+	}
+
+	query := `
+	query($owner: String!, $name: String!) {
+		repository(owner: $owner, name: $name) {
+			nameWithOwner
+			description
+			url
+			homepageUrl
+			stargazerCount
+			forkCount
+			watchers { totalCount }
+			pullRequests(states: OPEN) { totalCount }
+			issues(states: OPEN) { totalCount }
+			isPrivate
+			isFork
+			isArchived
+			pushedAt
+			licenseInfo { name spdxId }
+			repositoryTopics(first: 20) {
+				nodes { topic { name } }
+			}
+			latestRelease { name tagName url publishedAt }
+			languages(first: 10, orderBy: {field: SIZE, direction: DESC}) {
+				totalSize
+				edges {
+					size
+					node {
+						name
+						color
+					}
+				}
+			}
+		}
+		rateLimit {
+			limit
+			cost
+			remaining
+			resetAt
+		}
+	}`
+
+	var data struct {
+		Repository *struct {
+			NameWithOwner  string    `json:"nameWithOwner"`
+			Description    string    `json:"description"`
+			URL            string    `json:"url"`
+			HomepageURL    string    `json:"homepageUrl"`
+			StargazerCount int       `json:"stargazerCount"`
+			ForkCount      int       `json:"forkCount"`
+			IsPrivate      bool      `json:"isPrivate"`
+			IsFork         bool      `json:"isFork"`
+			IsArchived     bool      `json:"isArchived"`
+			PushedAt       time.Time `json:"pushedAt"`
+			Watchers       struct {
+				TotalCount int `json:"totalCount"`
+			} `json:"watchers"`
+			PullRequests struct {
+				TotalCount int `json:"totalCount"`
+			} `json:"pullRequests"`
+			Issues struct {
+				TotalCount int `json:"totalCount"`
+			} `json:"issues"`
+			LicenseInfo *struct {
+				Name   string `json:"name"`
+				SpdxID string `json:"spdxId"`
+			} `json:"licenseInfo"`
+			RepositoryTopics struct {
+				Nodes []struct {
+					Topic struct {
+						Name string `json:"name"`
+					} `json:"topic"`
+				} `json:"nodes"`
+			} `json:"repositoryTopics"`
+			LatestRelease *struct {
+				Name        string    `json:"name"`
+				TagName     string    `json:"tagName"`
+				URL         string    `json:"url"`
+				PublishedAt time.Time `json:"publishedAt"`
+			} `json:"latestRelease"`
+			Languages struct {
+				TotalSize int64 `json:"totalSize"`
+				Edges     []struct {
+					Size int64 `json:"size"`
+					Node struct {
+						Name  string `json:"name"`
+						Color string `json:"color"`
+					} `json:"node"`
+				} `json:"edges"`
+			} `json:"languages"`
+		} `json:"repository"`
+	}
+
+	variables := map[string]interface{}{"owner": owner, "name": name}
+	if err := c.graphQL(query, variables, &data); err != nil {
+		return nil, err
+	}
+	repo := data.Repository
+	if repo == nil {
+		return nil, fmt.Errorf("repository %s not found", fullName)
+	}
+
+	detail := &RepoDetail{
+		FullName:    repo.NameWithOwner,
+		Description: repo.Description,
+		HTMLURL:     repo.URL,
+		Homepage:    repo.HomepageURL,
+		Stars:       repo.StargazerCount,
+		Forks:       repo.ForkCount,
+		Watchers:    repo.Watchers.TotalCount,
+		OpenPRs:     repo.PullRequests.TotalCount,
+		OpenIssues:  repo.Issues.TotalCount,
+		Private:     repo.IsPrivate,
+		Fork:        repo.IsFork,
+		Archived:    repo.IsArchived,
+		PushedAt:    repo.PushedAt,
+	}
+	if repo.LicenseInfo != nil {
+		detail.License = repo.LicenseInfo.SpdxID
+		// NOASSERTION is GitHub's ID for licenses it couldn't identify
+		if detail.License == "" || detail.License == "NOASSERTION" {
+			detail.License = repo.LicenseInfo.Name
+		}
+	}
+	for _, node := range repo.RepositoryTopics.Nodes {
+		detail.Topics = append(detail.Topics, node.Topic.Name)
 	}
+	if release := repo.LatestRelease; release != nil {
+		detail.LatestRelease = &Release{
+			Name:        release.Name,
+			Tag:         release.TagName,
+			URL:         release.URL,
+			PublishedAt: release.PublishedAt,
+		}
+	}
+	for _, edge := range repo.Languages.Edges {
+		color := edge.Node.Color
+		if color == "" {
+			color = getLanguageColor(edge.Node.Name)
+		}
+		detail.Languages = append(detail.Languages, LanguageStats{
+			Name:       edge.Node.Name,
+			Percentage: float64(edge.Size) / float64(repo.Languages.TotalSize),
+			Color:      color,
+			Bytes:      edge.Size,
+		})
+	}
+
+	repoURL := fmt.Sprintf("%s/repos/%s", c.apiURL, detail.FullName)
+
+	detail.Readme, detail.ReadmeErr = c.fetchReadme(repoURL)
+	detail.CommitWeeks, detail.CommitsQueued, detail.CommitsErr = c.fetchCommitActivity(repoURL)
+	// Very large repositories answer 403 here
+	detail.Contributors, detail.ContributorsErr = c.fetchContributors(repoURL)
+
+	return detail, nil
+}
 
+// restGet GETs url with the given Accept header
+// The caller closes the response body.
+func (c *GitHubClient) restGet(url, accept string) (*http.Response, error) {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
 	// Authorization header automatically added by go-gh HTTPClient
-	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("Accept", accept)
 
-	resp, err := c.httpClient.Do(req)
+	return c.httpClient.Do(req)
+}
+
+// fetchReadme fetches a repository's README as raw markdown, or "" when it has none
+func (c *GitHubClient) fetchReadme(repoURL string) (string, error) {
+	resp, err := c.restGet(repoURL+"/readme", "application/vnd.github.raw+json")
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	defer resp.Body.Close()
 
-	var events []struct {
-		Type      string `json:"type"`
-		CreatedAt string `json:"created_at"`
-		Public    bool   `json:"public"`
-		Repo      struct {
-			Name string `json:"name"`
-		} `json:"repo"`
-		Payload map[string]interface{} `json:"payload"`
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return "", nil
+	default:
+		return "", apiError(resp)
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&events); err != nil {
-		return nil, err
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
 	}
+	return string(body), nil
+}
 
-	var activities []Activity
-	for _, event := range events {
-		timestamp, _ := time.Parse(time.RFC3339, event.CreatedAt)
-		action := getActionDescription(event.Type, event.Payload)
-
-		activities = append(activities, Activity{
-			Type:      event.Type,
-			Repo:      event.Repo.Name,
-			Action:    action,
-			Timestamp: timestamp,
-			Public:    event.Public,
-		})
+// fetchCommitActivity fetches commits per week for the last year, oldest first
+// queued is true while GitHub computes the statistics in the background (202 Accepted).
+func (c *GitHubClient) fetchCommitActivity(repoURL string) (weeks []int, queued bool, err error) {
+	resp, err := c.restGet(repoURL+"/stats/commit_activity", "application/vnd.github.v3+json")
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusAccepted:
+		return nil, true, nil
+	case http.StatusNoContent:
+		// Empty repository
+		return nil, false, nil
+	default:
+		return nil, false, apiError(resp)
 	}
 
-	return activities, nil
+	var activity []struct {
+		Total int `json:"total"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&activity); err != nil {
+		return nil, false, err
+	}
+	for _, week := range activity {
+		weeks = append(weeks, week.Total)
+	}
+	return weeks, false, nil
 }
 
-// getActionDescription creates human-readable action description
-func getActionDescription(eventType string, payload map[string]interface{}) string {
-	switch eventType {
+// fetchContributors fetches a repository's top contributors by commit count
+func (c *GitHubClient) fetchContributors(repoURL string) ([]Contributor, error) {
+	resp, err := c.restGet(fmt.Sprintf("%s/contributors?per_page=%d", repoURL, maxContributors), "application/vnd.github.v3+json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent:
+		// Empty repository
+		return nil, nil
+	default:
+		return nil, apiError(resp)
+	}
+
+	var contributors []Contributor
+	if err := json.NewDecoder(resp.Body).Decode(&contributors); err != nil {
+		return nil, err
+	}
+	return contributors, nil
+}
+
+// FetchRecentActivity fetches recent user activity
+// includePrivate: if true, uses /users/{username}/events to get private events (only works for authenticated user)
+func (c *GitHubClient) FetchRecentActivity(username string, includePrivate bool) ([]Activity, error) {
+	var url string
+
+	if includePrivate {
+		url = fmt.Sprintf("%s/users/%s/events?per_page=%d", c.apiURL, username, eventsPerPage)
+	} else {
+		url = fmt.Sprintf("%s/users/%s/events/public?per_page=%d", c.apiURL, username, eventsPerPage)
+	}
+
+	return c.fetchEvents(url)
+}
+
+// fetchEvents fetches every page of an events feed, newest first
+func (c *GitHubClient) fetchEvents(url string) ([]Activity, error) {
+	// The events API serves at most 300 events from the last 90 days
+	var activities []Activity
+	seen := make(map[string]bool)
+	err := c.fetchPages(url, maxEventPages, func(body io.Reader) error {
+		var events []struct {
+			ID        string `json:"id"`
+			Type      string `json:"type"`
+			CreatedAt string `json:"created_at"`
+			Public    bool   `json:"public"`
+			Repo      struct {
+				Name string `json:"name"`
+			} `json:"repo"`
+			Payload json.RawMessage `json:"payload"`
+		}
+		if err := json.NewDecoder(body).Decode(&events); err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			// New events shift later pages, so the same event can appear twice
+			if event.ID != "" && seen[event.ID] {
+				continue
+			}
+			seen[event.ID] = true
+
+			timestamp, _ := time.Parse(time.RFC3339, event.CreatedAt)
+			details := decodeEventPayload(event.Payload)
+
+			activities = append(activities, Activity{
+				ID:        event.ID,
+				Type:      event.Type,
+				Repo:      event.Repo.Name,
+				Action:    describeEvent(event.Type, details),
+				Details:   details,
+				Timestamp: timestamp,
+				Public:    event.Public,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].Timestamp.After(activities[j].Timestamp)
+	})
+
+	return activities, nil
+}
+
+// maxOrgMembers caps how many public members are summed into an
+// organization's contribution calendar; each costs one GraphQL query
+const maxOrgMembers = 30
+
+// orgCalendarWorkers is how many member calendars are fetched at once
+const orgCalendarWorkers = 4
+
+// FetchOrgProfile fetches organization metadata and its public members
+func (c *GitHubClient) FetchOrgProfile(org string) (*ProfileData, error) {
+	resp, err := c.restGet(fmt.Sprintf("%s/orgs/%s", c.apiURL, org), "application/vnd.github.v3+json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apiError(resp)
+	}
+
+	var data struct {
+		Login       string    `json:"login"`
+		Name        string    `json:"name"`
+		Description string    `json:"description"`
+		Location    string    `json:"location"`
+		Blog        string    `json:"blog"`
+		AvatarURL   string    `json:"avatar_url"`
+		PublicRepos int       `json:"public_repos"`
+		PublicGists int       `json:"public_gists"`
+		Followers   int       `json:"followers"`
+		CreatedAt   time.Time `json:"created_at"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	members, err := c.fetchOrgMembers(org)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProfileData{
+		Login:       data.Login,
+		Name:        data.Name,
+		Bio:         data.Description,
+		Location:    data.Location,
+		Blog:        data.Blog,
+		AvatarURL:   data.AvatarURL,
+		PublicRepos: data.PublicRepos,
+		PublicGists: data.PublicGists,
+		Followers:   data.Followers,
+		CreatedAt:   data.CreatedAt,
+		IsOrg:       true,
+		Members:     members,
+	}, nil
+}
+
+// fetchOrgMembers fetches the logins of an organization's public members
+func (c *GitHubClient) fetchOrgMembers(org string) ([]string, error) {
+	url := fmt.Sprintf("%s/orgs/%s/public_members?per_page=100", c.apiURL, org)
+
+	var logins []string
+	err := c.fetchPages(url, 0, func(body io.Reader) error {
+		var members []struct {
+			Login string `json:"login"`
+		}
+		if err := json.NewDecoder(body).Decode(&members); err != nil {
+			return err
+		}
+		for _, member := range members {
+			logins = append(logins, member.Login)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logins, nil
+}
+
+// FetchOrgContributions sums the contribution calendars of up to maxOrgMembers
+// members (from FetchOrgProfile) into one calendar. Members whose calendar
+// fails to load are skipped; it only fails if every member does.
+// year: calendar year to fetch, or 0 for the last 12 months
+func (c *GitHubClient) FetchOrgContributions(members []string, year int) (*ContributionCalendar, error) {
+	if len(members) > maxOrgMembers {
+		members = members[:maxOrgMembers]
+	}
+
+	calendars := make([]*ContributionCalendar, len(members))
+	errs := make([]error, len(members))
+	workers := make(chan struct{}, orgCalendarWorkers)
+	var wg sync.WaitGroup
+	for i, member := range members {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			workers <- struct{}{}
+			defer func() { <-workers }()
+			calendars[i], errs[i] = c.FetchContributions(member, year)
+		}()
+	}
+	wg.Wait()
+
+	merged := MergeContributionCalendars(year, calendars)
+	if merged.Members == 0 && len(members) > 0 {
+		return nil, errs[0]
+	}
+	return merged, nil
+}
+
+// MergeContributionCalendars sums calendars day by day, counting nil
+// (failed) calendars as skipped
+// The result has no GitHub levels, so the graph computes its own quartiles.
+func MergeContributionCalendars(year int, calendars []*ContributionCalendar) *ContributionCalendar {
+	merged := &ContributionCalendar{Year: year, Totals: &ContributionTotals{}}
+
+	counts := make(map[time.Time]int)
+	years := make(map[int]bool)
+	for _, calendar := range calendars {
+		if calendar == nil {
+			merged.Skipped++
+			continue
+		}
+		merged.Members++
+		for _, day := range calendar.Days {
+			if _, ok := counts[day.Date]; !ok {
+				merged.Days = append(merged.Days, Contribution{Date: day.Date})
+			}
+			counts[day.Date] += day.Count
+		}
+		for _, y := range calendar.Years {
+			years[y] = true
+		}
+		if t := calendar.Totals; t != nil {
+			merged.Totals.Commits += t.Commits
+			merged.Totals.PullRequests += t.PullRequests
+			merged.Totals.Reviews += t.Reviews
+			merged.Totals.Issues += t.Issues
+			if merged.Totals.StartedAt.IsZero() {
+				merged.Totals.StartedAt = t.StartedAt
+				merged.Totals.EndedAt = t.EndedAt
+			}
+		}
+	}
+
+	sort.Slice(merged.Days, func(i, j int) bool {
+		return merged.Days[i].Date.Before(merged.Days[j].Date)
+	})
+	for i := range merged.Days {
+		merged.Days[i].Count = counts[merged.Days[i].Date]
+	}
+	for y := range years {
+		merged.Years = append(merged.Years, y)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(merged.Years)))
+
+	return merged
+}
+
+// FetchOrgRepositories fetches every repository owned by the organization
+// Private repositories are included when the authenticated user can see them.
+func (c *GitHubClient) FetchOrgRepositories(org string) ([]Repository, error) {
+	url := fmt.Sprintf("%s/orgs/%s/repos?per_page=100&type=all", c.apiURL, org)
+
+	var allRepos []Repository
+	err := c.fetchPages(url, 0, func(body io.Reader) error {
+		var repos []Repository
+		if err := json.NewDecoder(body).Decode(&repos); err != nil {
+			return err
+		}
+		allRepos = append(allRepos, repos...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allRepos, nil
+}
+
+// FetchOrgActivity fetches recent public events in the organization's repositories
+func (c *GitHubClient) FetchOrgActivity(org string) ([]Activity, error) {
+	return c.fetchEvents(fmt.Sprintf("%s/orgs/%s/events?per_page=%d", c.apiURL, org, eventsPerPage))
+}
+
+// PullRequest is an open pull request involving the user
+type PullRequest struct {
+	Repo           string    `json:"repo"`
+	Number         int       `json:"number"`
+	Title          string    `json:"title"`
+	URL            string    `json:"url"`
+	IsDraft        bool      `json:"is_draft"`
+	CIStatus       string    `json:"ci_status"`       // statusCheckRollup state: SUCCESS, FAILURE, PENDING, ...; "" without checks
+	ReviewDecision string    `json:"review_decision"` // APPROVED, CHANGES_REQUESTED, REVIEW_REQUIRED or ""
+	Role           string    `json:"role"`            // How the user is involved: author, reviewer or assignee
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// pullRequestSearches are the searches behind the Pull Requests column,
+// in order of precedence when a PR matches several
+var pullRequestSearches = []struct {
+	alias     string
+	qualifier string
+	role      string
+}{
+	{"authored", "author", "author"},
+	{"reviewRequested", "review-requested", "reviewer"},
+	{"assigned", "assignee", "assignee"},
+}
+
+// FetchPullRequests fetches open pull requests the user authored, was asked
+// to review, or is assigned to, most recently updated first
+func (c *GitHubClient) FetchPullRequests(username string) ([]PullRequest, error) {
+	var aliases []string
+	variables := map[string]interface{}{}
+	for _, search := range pullRequestSearches {
+		aliases = append(aliases, search.alias)
+		variables[search.alias] = fmt.Sprintf("is:pr is:open archived:false %s:%s sort:updated-desc", search.qualifier, username)
+	}
+	searches, params := aliasedSearches(aliases, "nodes { ...pr }")
+
+	query := fmt.Sprintf(`
+	query(%s) {
+		%s
+		rateLimit {
+			limit
+			cost
+			remaining
+			resetAt
+		}
+	}
+
+	fragment pr on PullRequest {
+		number
+		title
+		url
+		isDraft
+		createdAt
+		updatedAt
+		reviewDecision
+		repository { nameWithOwner }
+		commits(last: 1) {
+			nodes { commit { statusCheckRollup { state } } }
+		}
+	}`, params, searches)
+
+	type prNode struct {
+		Number         int       `json:"number"`
+		Title          string    `json:"title"`
+		URL            string    `json:"url"`
+		IsDraft        bool      `json:"isDraft"`
+		CreatedAt      time.Time `json:"createdAt"`
+		UpdatedAt      time.Time `json:"updatedAt"`
+		ReviewDecision string    `json:"reviewDecision"`
+		Repository     struct {
+			NameWithOwner string `json:"nameWithOwner"`
+		} `json:"repository"`
+		Commits struct {
+			Nodes []struct {
+				Commit struct {
+					StatusCheckRollup *struct {
+						State string `json:"state"`
+					} `json:"statusCheckRollup"`
+				} `json:"commit"`
+			} `json:"nodes"`
+		} `json:"commits"`
+	}
+
+	var data map[string]json.RawMessage
+	if err := c.graphQL(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	var prs []PullRequest
+	seen := make(map[string]bool)
+	for _, search := range pullRequestSearches {
+		var result struct {
+			Nodes []prNode `json:"nodes"`
+		}
+		if raw, ok := data[search.alias]; ok {
+			if err := json.Unmarshal(raw, &result); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, node := range result.Nodes {
+			// Search can return empty nodes for items the fragment doesn't match
+			if node.URL == "" || seen[node.URL] {
+				continue
+			}
+			seen[node.URL] = true
+
+			pr := PullRequest{
+				Repo:           node.Repository.NameWithOwner,
+				Number:         node.Number,
+				Title:          node.Title,
+				URL:            node.URL,
+				IsDraft:        node.IsDraft,
+				ReviewDecision: node.ReviewDecision,
+				Role:           search.role,
+				CreatedAt:      node.CreatedAt,
+				UpdatedAt:      node.UpdatedAt,
+			}
+			if len(node.Commits.Nodes) > 0 && node.Commits.Nodes[0].Commit.StatusCheckRollup != nil {
+				pr.CIStatus = node.Commits.Nodes[0].Commit.StatusCheckRollup.State
+			}
+			prs = append(prs, pr)
+		}
+	}
+
+	sort.SliceStable(prs, func(i, j int) bool {
+		return prs[i].UpdatedAt.After(prs[j].UpdatedAt)
+	})
+
+	return prs, nil
+}
+
+// aliasedSearches builds one aliased search field per alias, each reading its
+// query string from the variable of the same name, and the variable declarations
+func aliasedSearches(aliases []string, selection string) (fields, params string) {
+	var b strings.Builder
+	var decls []string
+	for _, alias := range aliases {
+		fmt.Fprintf(&b, `
+		%s: search(query: $%s, type: ISSUE, first: 30) {
+			%s
+		}`, alias, alias, selection)
+		decls = append(decls, "$"+alias+": String!")
+	}
+	return b.String(), strings.Join(decls, ", ")
+}
+
+// Issue is an open issue involving the user
+type Issue struct {
+	Repo      string       `json:"repo"`
+	Number    int          `json:"number"`
+	Title     string       `json:"title"`
+	URL       string       `json:"url"`
+	Labels    []IssueLabel `json:"labels,omitempty"`
+	Milestone string       `json:"milestone,omitempty"`
+	Comments  int          `json:"comments"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// IssueLabel is a label with its GitHub color (hex, without '#')
+type IssueLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// IssueQuery selects which search the Issues column lists
+type IssueQuery int
+
+const (
+	IssuesAssigned IssueQuery = iota
+	IssuesAuthored
+	IssuesMentioned
+)
+
+// issueSearches are the searches behind the Issues column, indexed by IssueQuery
+var issueSearches = []struct {
+	alias     string
+	qualifier string
+}{
+	{"assigned", "assignee"},
+	{"authored", "author"},
+	{"mentioned", "mentions"},
+}
+
+// String returns the display name for a query
+func (q IssueQuery) String() string {
+	return issueSearches[q].alias
+}
+
+// Next returns the query after q, wrapping around
+func (q IssueQuery) Next() IssueQuery {
+	return (q + 1) % IssueQuery(len(issueSearches))
+}
+
+// IssueSearch is the result of one Issues column search
+type IssueSearch struct {
+	Total  int     `json:"total"` // All matching issues; Issues holds the first 30
+	Issues []Issue `json:"issues"`
+}
+
+// FetchIssues runs every Issues column search in a single query and returns
+// the results indexed by IssueQuery, most recently updated first
+func (c *GitHubClient) FetchIssues(username string) ([]IssueSearch, error) {
+	var aliases []string
+	variables := map[string]interface{}{}
+	for _, search := range issueSearches {
+		aliases = append(aliases, search.alias)
+		variables[search.alias] = fmt.Sprintf("is:issue is:open archived:false %s:%s sort:updated-desc", search.qualifier, username)
+	}
+	searches, params := aliasedSearches(aliases, "issueCount nodes { ...issue }")
+
+	query := fmt.Sprintf(`
+	query(%s) {
+		%s
+		rateLimit {
+			limit
+			cost
+			remaining
+			resetAt
+		}
+	}
+
+	fragment issue on Issue {
+		number
+		title
+		url
+		updatedAt
+		repository { nameWithOwner }
+		labels(first: 10) { nodes { name color } }
+		milestone { title }
+		comments { totalCount }
+	}`, params, searches)
+
+	type issueNode struct {
+		Number     int       `json:"number"`
+		Title      string    `json:"title"`
+		URL        string    `json:"url"`
+		UpdatedAt  time.Time `json:"updatedAt"`
+		Repository struct {
+			NameWithOwner string `json:"nameWithOwner"`
+		} `json:"repository"`
+		Labels struct {
+			Nodes []IssueLabel `json:"nodes"`
+		} `json:"labels"`
+		Milestone *struct {
+			Title string `json:"title"`
+		} `json:"milestone"`
+		Comments struct {
+			TotalCount int `json:"totalCount"`
+		} `json:"comments"`
+	}
+
+	var data map[string]json.RawMessage
+	if err := c.graphQL(query, variables, &data); err != nil {
+		return nil, err
+	}
+
+	results := make([]IssueSearch, len(issueSearches))
+	for i, search := range issueSearches {
+		var result struct {
+			IssueCount int         `json:"issueCount"`
+			Nodes      []issueNode `json:"nodes"`
+		}
+		if raw, ok := data[search.alias]; ok {
+			if err := json.Unmarshal(raw, &result); err != nil {
+				return nil, err
+			}
+		}
+
+		results[i].Total = result.IssueCount
+		for _, node := range result.Nodes {
+			// Search can return empty nodes for items the fragment doesn't match
+			if node.URL == "" {
+				continue
+			}
+			issue := Issue{
+				Repo:      node.Repository.NameWithOwner,
+				Number:    node.Number,
+				Title:     node.Title,
+				URL:       node.URL,
+				Labels:    node.Labels.Nodes,
+				Comments:  node.Comments.TotalCount,
+				UpdatedAt: node.UpdatedAt,
+			}
+			if node.Milestone != nil {
+				issue.Milestone = node.Milestone.Title
+			}
+			results[i].Issues = append(results[i].Issues, issue)
+		}
+	}
+
+	return results, nil
+}
+
+// graphQLRateLimit is the rateLimit object requested alongside GraphQL queries
+type graphQLRateLimit struct {
+	Limit     int       `json:"limit"`
+	Cost      int       `json:"cost"`
+	Remaining int       `json:"remaining"`
+	ResetAt   time.Time `json:"resetAt"`
+}
+
+// graphQLError is a single entry of a GraphQL "errors" array
+type graphQLError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// recordGraphQLRateLimit stores GraphQL quota including the query's point cost
+func (c *GitHubClient) recordGraphQLRateLimit(rl graphQLRateLimit) {
+	if rl.Limit == 0 {
+		return
+	}
+	c.rateLimits.Update(RateLimit{
+		Resource:  "graphql",
+		Limit:     rl.Limit,
+		Remaining: rl.Remaining,
+		Used:      rl.Limit - rl.Remaining,
+		Reset:     rl.ResetAt,
+		LastCost:  rl.Cost,
+	})
+}
+
+// graphQLErrors converts GraphQL errors into a single error
+// RATE_LIMITED errors report when the GraphQL quota resets
+func (c *GitHubClient) graphQLErrors(errs []graphQLError) error {
+	for _, e := range errs {
+		if e.Type != "RATE_LIMITED" {
+			continue
+		}
+		if rl, ok := c.rateLimits.Get("graphql"); ok && !rl.Reset.IsZero() {
+			return fmt.Errorf("GitHub GraphQL rate limit exceeded; resets at %s (in %s)",
+				rl.Reset.Local().Format("15:04"), formatUntil(rl.Reset))
+		}
+		return fmt.Errorf("GitHub GraphQL rate limit exceeded: %s", e.Message)
+	}
+	return fmt.Errorf("GitHub GraphQL error: %s", errs[0].Message)
+}
+
+// graphQL executes a GraphQL query and decodes its "data" object into data
+// Queries should select rateLimit { limit cost remaining resetAt } so quota is recorded.
+func (c *GitHubClient) graphQL(query string, variables map[string]interface{}, data interface{}) error {
+	reqBody := map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", c.graphqlURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return err
+	}
+	// Authorization header automatically added by go-gh HTTPClient
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return apiError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []graphQLError  `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return err
+	}
+
+	var meta struct {
+		RateLimit graphQLRateLimit `json:"rateLimit"`
+	}
+	if len(result.Data) > 0 && string(result.Data) != "null" {
+		if err := json.Unmarshal(result.Data, &meta); err == nil {
+			c.recordGraphQLRateLimit(meta.RateLimit)
+		}
+	}
+
+	if len(result.Errors) > 0 {
+		return c.graphQLErrors(result.Errors)
+	}
+
+	return json.Unmarshal(result.Data, data)
+}
+
+// eventPayload is the union of the payload fields gittui reads from events
+type eventPayload struct {
+	Action  string `json:"action"`
+	Ref     string `json:"ref"`
+	RefType string `json:"ref_type"`
+	Size    int    `json:"size"`
+	Commits []struct {
+		SHA     string `json:"sha"`
+		Message string `json:"message"`
+		Author  struct {
+			Name string `json:"name"`
+		} `json:"author"`
+	} `json:"commits"`
+	PullRequest *struct {
+		Number  int    `json:"number"`
+		Title   string `json:"title"`
+		Body    string `json:"body"`
+		HTMLURL string `json:"html_url"`
+		Merged  bool   `json:"merged"`
+	} `json:"pull_request"`
+	Issue *struct {
+		Number      int       `json:"number"`
+		Title       string    `json:"title"`
+		Body        string    `json:"body"`
+		HTMLURL     string    `json:"html_url"`
+		PullRequest *struct{} `json:"pull_request"`
+	} `json:"issue"`
+	Comment *struct {
+		HTMLURL  string `json:"html_url"`
+		CommitID string `json:"commit_id"`
+	} `json:"comment"`
+	Review *struct {
+		State   string `json:"state"`
+		HTMLURL string `json:"html_url"`
+	} `json:"review"`
+	Release *struct {
+		TagName string `json:"tag_name"`
+		Name    string `json:"name"`
+		HTMLURL string `json:"html_url"`
+	} `json:"release"`
+	Pages []struct {
+		Title   string `json:"title"`
+		HTMLURL string `json:"html_url"`
+	} `json:"pages"`
+	Member *struct {
+		Login string `json:"login"`
+	} `json:"member"`
+	Forkee *struct {
+		FullName string `json:"full_name"`
+		HTMLURL  string `json:"html_url"`
+	} `json:"forkee"`
+}
+
+// decodeEventPayload decodes an event payload into EventDetails
+// Unknown or malformed payloads yield empty details rather than an error
+func decodeEventPayload(raw json.RawMessage) EventDetails {
+	var p eventPayload
+	if len(raw) == 0 || json.Unmarshal(raw, &p) != nil {
+		return EventDetails{}
+	}
+
+	d := EventDetails{
+		Action:      p.Action,
+		RefType:     p.RefType,
+		Ref:         strings.TrimPrefix(strings.TrimPrefix(p.Ref, "refs/heads/"), "refs/tags/"),
+		CommitCount: p.Size,
+	}
+	for _, commit := range p.Commits {
+		headline, _, _ := strings.Cut(commit.Message, "\n")
+		d.Commits = append(d.Commits, EventCommit{SHA: commit.SHA, Message: headline, Author: commit.Author.Name})
+	}
+	if d.CommitCount < len(d.Commits) {
+		d.CommitCount = len(d.Commits)
+	}
+
+	if p.Issue != nil {
+		d.Number, d.Title, d.URL = p.Issue.Number, p.Issue.Title, p.Issue.HTMLURL
+		d.Body = excerpt(p.Issue.Body, maxBodyExcerpt)
+		d.IsPR = p.Issue.PullRequest != nil
+	}
+	if p.PullRequest != nil {
+		d.Number, d.Title, d.URL = p.PullRequest.Number, p.PullRequest.Title, p.PullRequest.HTMLURL
+		d.Body = excerpt(p.PullRequest.Body, maxBodyExcerpt)
+		d.IsPR = true
+		d.Merged = p.PullRequest.Merged
+	}
+	if p.Review != nil {
+		d.ReviewState = strings.ToLower(p.Review.State)
+		d.URL = p.Review.HTMLURL
+	}
+	if p.Comment != nil {
+		d.CommitID = p.Comment.CommitID
+		d.URL = p.Comment.HTMLURL
+	}
+	if p.Release != nil {
+		d.Tag, d.Title, d.URL = p.Release.TagName, p.Release.Name, p.Release.HTMLURL
+	}
+	if len(p.Pages) > 0 {
+		d.Pages = len(p.Pages)
+		d.Title, d.URL = p.Pages[0].Title, p.Pages[0].HTMLURL
+	}
+	if p.Member != nil {
+		d.Member = p.Member.Login
+	}
+	if p.Forkee != nil {
+		d.Fork, d.URL = p.Forkee.FullName, p.Forkee.HTMLURL
+	}
+
+	return d
+}
+
+// excerpt collapses whitespace in s and truncates it to max runes
+func excerpt(s string, max int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return strings.TrimSpace(string(runes[:max-1])) + "…"
+}
+
+// describeEvent creates a human-readable description of an event
+func describeEvent(eventType string, d EventDetails) string {
+	// "PR #42: fix auth" or "issue #7: crash on start"
+	subject := func(kind string) string {
+		if d.Number == 0 {
+			return kind
+		}
+		if d.Title == "" {
+			return fmt.Sprintf("%s #%d", kind, d.Number)
+		}
+		return fmt.Sprintf("%s #%d: %s", kind, d.Number, d.Title)
+	}
+
+	switch eventType {
 	case "PushEvent":
-		if commits, ok := payload["commits"].([]interface{}); ok {
-			return fmt.Sprintf("Pushed %d commit(s)", len(commits))
+		desc := fmt.Sprintf("Pushed %d commit(s)", d.CommitCount)
+		if d.Ref != "" {
+			desc += " to " + d.Ref
+		}
+		if len(d.Commits) > 0 {
+			desc += ": " + d.Commits[len(d.Commits)-1].Message
 		}
-		return "Pushed commits"
+		return desc
 	case "CreateEvent":
-		if refType, ok := payload["ref_type"].(string); ok {
-			return fmt.Sprintf("Created %s", refType)
+		if d.RefType == "" || d.RefType == "repository" {
+			return "Created repository"
 		}
-		return "Created repository"
+		return fmt.Sprintf("Created %s %s", d.RefType, d.Ref)
+	case "DeleteEvent":
+		return fmt.Sprintf("Deleted %s %s", d.RefType, d.Ref)
 	case "PullRequestEvent":
-		if action, ok := payload["action"].(string); ok {
-			return fmt.Sprintf("Pull request %s", action)
+		if d.Action == "closed" && d.Merged {
+			return "Merged " + subject("PR")
 		}
-		return "Pull request activity"
+		return capitalize(d.Action, "Updated") + " " + subject("PR")
+	case "PullRequestReviewEvent":
+		switch d.ReviewState {
+		case "approved":
+			return "Approved " + subject("PR")
+		case "changes_requested":
+			return "Requested changes on " + subject("PR")
+		default:
+			return "Reviewed " + subject("PR")
+		}
+	case "PullRequestReviewCommentEvent":
+		return "Commented on " + subject("PR")
 	case "IssuesEvent":
-		if action, ok := payload["action"].(string); ok {
-			return fmt.Sprintf("Issue %s", action)
+		return capitalize(d.Action, "Updated") + " " + subject("issue")
+	case "IssueCommentEvent":
+		if d.IsPR {
+			return "Commented on " + subject("PR")
+		}
+		return "Commented on " + subject("issue")
+	case "CommitCommentEvent":
+		if len(d.CommitID) >= 7 {
+			return "Commented on commit " + d.CommitID[:7]
+		}
+		return "Commented on a commit"
+	case "ReleaseEvent":
+		tag := d.Tag
+		if d.Title != "" && d.Title != d.Tag {
+			tag += " (" + d.Title + ")"
 		}
-		return "Issue activity"
+		return capitalize(d.Action, "Published") + " release " + tag
 	case "WatchEvent":
 		return "Starred repository"
 	case "ForkEvent":
+		if d.Fork != "" {
+			return "Forked to " + d.Fork
+		}
 		return "Forked repository"
+	case "GollumEvent":
+		if d.Pages > 1 {
+			return fmt.Sprintf("Edited wiki page %s (+%d more)", d.Title, d.Pages-1)
+		}
+		return "Edited wiki page " + d.Title
+	case "MemberEvent":
+		return capitalize(d.Action, "Added") + " member @" + d.Member
+	case "PublicEvent":
+		return "Made repository public"
 	default:
-		return eventType
+		return strings.TrimSuffix(eventType, "Event")
+	}
+}
+
+// capitalize upper-cases the first letter of a payload action, using fallback if empty
+func capitalize(action, fallback string) string {
+	if action == "" {
+		return fallback
 	}
+	return strings.ToUpper(action[:1]) + strings.ReplaceAll(action[1:], "_", " ")
 }
 
-// getLanguageColor returns GitHub's language colors
+//go:generate go run ./scripts/genlanguages -ref f101af52dce8
+
+// getLanguageColor returns GitHub's linguist color for a language name or alias
 func getLanguageColor(lang string) string {
-	colors := map[string]string{
-		"Go":         "#00ADD8",
-		"JavaScript": "#f1e05a",
-		"TypeScript": "#3178c6",
-		"Python":     "#3572A5",
-		"Rust":       "#dea584",
-		"Java":       "#b07219",
-		"C":          "#555555",
-		"C++":        "#f34b7d",
-		"Ruby":       "#701516",
-		"PHP":        "#4F5D95",
-		"Swift":      "#ffac45",
-		"Kotlin":     "#A97BFF",
-		"Shell":      "#89e051",
-		"HTML":       "#e34c26",
-		"CSS":        "#563d7c",
-	}
-	if color, ok := colors[lang]; ok {
+	if color, ok := linguistColors[lang]; ok {
 		return color
 	}
+	if name, ok := linguistAliases[strings.ToLower(lang)]; ok {
+		if color, ok := linguistColors[name]; ok {
+			return color
+		}
+	}
 	return "#858585" // Default gray
 }
+
+// Notification is a thread in the authenticated user's notifications inbox
+type Notification struct {
+	ID        string    `json:"id"`
+	Repo      string    `json:"repo"`
+	Reason    string    `json:"reason"` // Why the user was notified, e.g. review_requested or mention
+	Type      string    `json:"type"`   // Subject type: Issue, PullRequest, Release, Commit, Discussion, ...
+	Title     string    `json:"title"`
+	URL       string    `json:"url"` // Web URL of the subject, or of the repository when unknown
+	Unread    bool      `json:"unread"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NotificationPoll is the result of polling the notifications inbox
+type NotificationPoll struct {
+	Notifications []Notification
+	NotModified   bool          // Nothing changed since lastModified; Notifications is empty
+	LastModified  string        // Pass to the next poll
+	PollInterval  time.Duration // Wait at least this long before polling again
+}
+
+// defaultNotificationPollInterval is used when the API doesn't send X-Poll-Interval
+const defaultNotificationPollInterval = 60 * time.Second
+
+// FetchNotifications polls the unread notifications inbox.
+// lastModified is the previous poll's LastModified; when nothing has changed
+// GitHub answers 304, which doesn't count against the rate limit.
+func (c *GitHubClient) FetchNotifications(lastModified string) (*NotificationPoll, error) {
+	url := fmt.Sprintf("%s/notifications?per_page=50", c.apiURL)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Authorization header automatically added by go-gh HTTPClient
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	// Our own conditional request bypasses the response cache
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	poll := &NotificationPoll{
+		LastModified: lastModified,
+		PollInterval: defaultNotificationPollInterval,
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("X-Poll-Interval")); err == nil && seconds > 0 {
+		poll.PollInterval = time.Duration(seconds) * time.Second
+	}
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		poll.NotModified = true
+		return poll, nil
+	case http.StatusOK:
+	default:
+		return nil, apiError(resp)
+	}
+
+	var threads []struct {
+		ID        string    `json:"id"`
+		Reason    string    `json:"reason"`
+		Unread    bool      `json:"unread"`
+		UpdatedAt time.Time `json:"updated_at"`
+		Subject   struct {
+			Title string `json:"title"`
+			URL   string `json:"url"`
+			Type  string `json:"type"`
+		} `json:"subject"`
+		Repository struct {
+			FullName string `json:"full_name"`
+			HTMLURL  string `json:"html_url"`
+		} `json:"repository"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&threads); err != nil {
+		return nil, err
+	}
+
+	if lm := resp.Header.Get("Last-Modified"); lm != "" {
+		poll.LastModified = lm
+	}
+	for _, thread := range threads {
+		poll.Notifications = append(poll.Notifications, Notification{
+			ID:        thread.ID,
+			Repo:      thread.Repository.FullName,
+			Reason:    thread.Reason,
+			Type:      thread.Subject.Type,
+			Title:     thread.Subject.Title,
+			URL:       notificationWebURL(thread.Repository.HTMLURL, thread.Subject.URL),
+			Unread:    thread.Unread,
+			UpdatedAt: thread.UpdatedAt,
+		})
+	}
+
+	return poll, nil
+}
+
+// notificationWebURL maps a notification subject's API URL
+// (…/repos/o/r/pulls/1) to its page under the repository's web URL
+func notificationWebURL(repoURL, subjectURL string) string {
+	_, rest, ok := strings.Cut(subjectURL, "/repos/")
+	if !ok {
+		return repoURL
+	}
+	// Skip owner/name
+	parts := strings.SplitN(rest, "/", 4)
+	if len(parts) < 4 {
+		return repoURL
+	}
+	kind, id := parts[2], parts[3]
+	switch kind {
+	case "pulls":
+		return repoURL + "/pull/" + id
+	case "issues":
+		return repoURL + "/issues/" + id
+	case "commits":
+		return repoURL + "/commit/" + id
+	default:
+		// Releases and others are addressed by API IDs with no web equivalent
+		return repoURL
+	}
+}
+
+// MarkNotificationRead marks a notification thread as read
+func (c *GitHubClient) MarkNotificationRead(threadID string) error {
+	return c.notificationThreadRequest("PATCH", threadID, "")
+}
+
+// MarkNotificationDone marks a notification thread as done, removing it from the inbox
+func (c *GitHubClient) MarkNotificationDone(threadID string) error {
+	return c.notificationThreadRequest("DELETE", threadID, "")
+}
+
+// UnsubscribeNotification stops notifications for a thread until the user
+// comments on it or is mentioned again
+func (c *GitHubClient) UnsubscribeNotification(threadID string) error {
+	return c.notificationThreadRequest("DELETE", threadID, "/subscription")
+}
+
+// notificationThreadRequest sends a bodiless request to a notification thread endpoint
+func (c *GitHubClient) notificationThreadRequest(method, threadID, suffix string) error {
+	url := fmt.Sprintf("%s/notifications/threads/%s%s", c.apiURL, threadID, suffix)
+
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return err
+	}
+	// Authorization header automatically added by go-gh HTTPClient
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// 205 Reset Content for read, 204 No Content for done and unsubscribe
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return apiError(resp)
+	}
+	return nil
+}
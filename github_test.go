@@ -0,0 +1,525 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGitHubClientAgainstStandInServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/octocat" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"login":"octocat","name":"The Octocat","public_repos":8,"followers":42}`))
+	}))
+	defer server.Close()
+
+	var source ProfileSource = NewGitHubClientWithHTTP(server.Client(), server.URL, server.URL+"/graphql")
+
+	profile, err := source.FetchProfile("octocat", false)
+	if err != nil {
+		t.Fatalf("FetchProfile returned error: %v", err)
+	}
+	if profile.Login != "octocat" || profile.PublicRepos != 8 || profile.Followers != 42 {
+		t.Errorf("unexpected profile: %+v", profile)
+	}
+
+	if _, err := source.FetchProfile("ghost", false); err == nil {
+		t.Error("expected error for unknown user")
+	}
+}
+
+func TestFetchAllRepositoriesFollowsLinkHeader(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", `<`+server.URL+`/users/octocat/repos?per_page=100&page=2>; rel="next", <`+server.URL+`/users/octocat/repos?per_page=100&page=2>; rel="last"`)
+			w.Write([]byte(`[{"name":"a","language":"Go","stargazers_count":1},{"name":"b","language":"Go","fork":true,"stargazers_count":9}]`))
+		case "2":
+			w.Write([]byte(`[{"name":"c","language":"Rust","stargazers_count":5}]`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewGitHubClientWithHTTP(server.Client(), server.URL, server.URL+"/graphql")
+	repos, err := client.FetchAllRepositories("octocat", false)
+	if err != nil {
+		t.Fatalf("FetchAllRepositories returned error: %v", err)
+	}
+	if len(repos) != 3 {
+		t.Fatalf("expected 3 repos across 2 pages, got %d", len(repos))
+	}
+
+	languages, count := LanguageStatsFromRepositories(repos)
+	if count != 3 {
+		t.Errorf("repo count = %d, want 3", count)
+	}
+	// Forks are excluded from language stats
+	if len(languages) != 2 || languages[0].Percentage != 0.5 {
+		t.Errorf("unexpected languages: %+v", languages)
+	}
+
+	top := TopRepositories(repos, 2)
+	if len(top) != 2 || top[0].Name != "b" || top[1].Name != "c" {
+		t.Errorf("unexpected top repos: %+v", top)
+	}
+}
+
+func TestFetchLanguageBytesAggregatesAcrossPages(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Write([]byte(`{"data":{"repositoryOwner":{"repositories":{"nodes":[
+				{"languages":{"edges":[{"size":300,"node":{"name":"Go","color":"#00ADD8"}},{"size":100,"node":{"name":"Shell","color":"#89e051"}}]}}
+			],"pageInfo":{"hasNextPage":true,"endCursor":"abc"}}},
+			"rateLimit":{"limit":5000,"cost":1,"remaining":4999,"resetAt":"2030-01-01T00:00:00Z"}}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"repositoryOwner":{"repositories":{"nodes":[
+			{"languages":{"edges":[{"size":600,"node":{"name":"Zig","color":"#ec915c"}}]}}
+		],"pageInfo":{"hasNextPage":false,"endCursor":""}}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewGitHubClientWithHTTP(server.Client(), server.URL, server.URL)
+	languages, err := client.FetchLanguageBytes("octocat", false)
+	if err != nil {
+		t.Fatalf("FetchLanguageBytes returned error: %v", err)
+	}
+
+	if len(languages) != 3 || languages[0].Name != "Zig" || languages[0].Percentage != 0.6 {
+		t.Fatalf("unexpected languages: %+v", languages)
+	}
+	if languages[0].Color != "#ec915c" || languages[1].Bytes != 300 {
+		t.Errorf("expected API color and byte totals, got %+v", languages)
+	}
+
+	if rl, ok := client.RateLimits().Get("graphql"); !ok || rl.Remaining != 4999 || rl.LastCost != 1 {
+		t.Errorf("GraphQL rate limit not recorded: %+v", rl)
+	}
+}
+
+func TestAPIURLsForHost(t *testing.T) {
+	tests := []struct {
+		host        string
+		wantAPI     string
+		wantGraphQL string
+	}{
+		{"github.com", "https://api.github.com", "https://api.github.com/graphql"},
+		{"", "https://api.github.com", "https://api.github.com/graphql"},
+		{"github.example.com", "https://github.example.com/api/v3", "https://github.example.com/api/graphql"},
+	}
+
+	for _, tt := range tests {
+		api, graphql := apiURLsForHost(tt.host)
+		if api != tt.wantAPI || graphql != tt.wantGraphQL {
+			t.Errorf("apiURLsForHost(%q) = %s, %s; want %s, %s", tt.host, api, graphql, tt.wantAPI, tt.wantGraphQL)
+		}
+	}
+}
+
+func TestFetchRecentActivityPaginatesAndDeduplicates(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", `<`+server.URL+`/users/octocat/events/public?per_page=100&page=2>; rel="next"`)
+			w.Write([]byte(`[
+				{"id":"3","type":"PushEvent","created_at":"2025-03-03T10:00:00Z","repo":{"name":"o/a"},"payload":{}},
+				{"id":"2","type":"WatchEvent","created_at":"2025-03-02T10:00:00Z","repo":{"name":"o/b"},"payload":{}}
+			]`))
+		case "2":
+			// A new event arrived between requests, shifting "2" onto this page
+			w.Write([]byte(`[
+				{"id":"2","type":"WatchEvent","created_at":"2025-03-02T10:00:00Z","repo":{"name":"o/b"},"payload":{}},
+				{"id":"1","type":"ForkEvent","created_at":"2025-03-01T10:00:00Z","repo":{"name":"o/c"},"payload":{}}
+			]`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewGitHubClientWithHTTP(server.Client(), server.URL, server.URL+"/graphql")
+	activities, err := client.FetchRecentActivity("octocat", false)
+	if err != nil {
+		t.Fatalf("FetchRecentActivity returned error: %v", err)
+	}
+	if len(activities) != 3 {
+		t.Fatalf("expected 3 unique events, got %d: %+v", len(activities), activities)
+	}
+	if activities[0].ID != "3" || activities[2].ID != "1" {
+		t.Errorf("expected newest first, got %+v", activities)
+	}
+
+	if oldest, _ := ActivityCoverage(activities); !oldest.Equal(activities[2].Timestamp) {
+		t.Errorf("coverage oldest = %s, want %s", oldest, activities[2].Timestamp)
+	}
+}
+
+func TestDescribeEvent(t *testing.T) {
+	tests := []struct {
+		eventType string
+		payload   string
+		want      string
+	}{
+		{"PushEvent", `{"ref":"refs/heads/main","size":2,"commits":[{"message":"first"},{"message":"fix auth\n\nlong body"}]}`, "Pushed 2 commit(s) to main: fix auth"},
+		{"PullRequestEvent", `{"action":"closed","pull_request":{"number":42,"title":"fix auth","merged":true}}`, "Merged PR #42: fix auth"},
+		{"PullRequestEvent", `{"action":"opened","pull_request":{"number":7,"title":"add tz"}}`, "Opened PR #7: add tz"},
+		{"PullRequestReviewEvent", `{"review":{"state":"APPROVED"},"pull_request":{"number":3,"title":"x"}}`, "Approved PR #3: x"},
+		{"IssueCommentEvent", `{"action":"created","issue":{"number":9,"title":"crash","pull_request":{}}}`, "Commented on PR #9: crash"},
+		{"IssuesEvent", `{"action":"reopened","issue":{"number":5,"title":"bug"}}`, "Reopened issue #5: bug"},
+		{"ReleaseEvent", `{"action":"published","release":{"tag_name":"v1.2.0","name":"v1.2.0"}}`, "Published release v1.2.0"},
+		{"CreateEvent", `{"ref":"feature","ref_type":"branch"}`, "Created branch feature"},
+		{"DeleteEvent", `{"ref":"v0.1","ref_type":"tag"}`, "Deleted tag v0.1"},
+		{"CommitCommentEvent", `{"comment":{"commit_id":"abcdef1234567"}}`, "Commented on commit abcdef1"},
+		{"GollumEvent", `{"pages":[{"title":"Home"},{"title":"Setup"}]}`, "Edited wiki page Home (+1 more)"},
+		{"MemberEvent", `{"action":"added","member":{"login":"hubot"}}`, "Added member @hubot"},
+		{"ForkEvent", `{"forkee":{"full_name":"me/repo"}}`, "Forked to me/repo"},
+		{"PublicEvent", `{}`, "Made repository public"},
+		{"SponsorshipEvent", `{}`, "Sponsorship"},
+	}
+
+	for _, tt := range tests {
+		got := describeEvent(tt.eventType, decodeEventPayload([]byte(tt.payload)))
+		if got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.eventType, got, tt.want)
+		}
+	}
+}
+
+func TestActivityWebURL(t *testing.T) {
+	tests := []struct {
+		activity Activity
+		want     string
+	}{
+		{Activity{Repo: "o/r", Details: EventDetails{URL: "https://github.com/o/r/pull/1"}}, "https://github.com/o/r/pull/1"},
+		{Activity{Repo: "o/r", Type: "PushEvent", Details: EventDetails{Ref: "main", Commits: []EventCommit{{SHA: "abc"}}}}, "https://github.com/o/r/commit/abc"},
+		{Activity{Repo: "o/r", Type: "PushEvent", Details: EventDetails{Ref: "main", Commits: []EventCommit{{SHA: "a"}, {SHA: "b"}}}}, "https://github.com/o/r/tree/main"},
+		{Activity{Repo: "o/r", Type: "DeleteEvent", Details: EventDetails{Ref: "gone"}}, "https://github.com/o/r"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.activity.WebURL("github.com"); got != tt.want {
+			t.Errorf("WebURL(%+v) = %s, want %s", tt.activity, got, tt.want)
+		}
+	}
+
+	details := decodeEventPayload([]byte(`{"commits":[{"sha":"abc","message":"fix\n\nbody","author":{"name":"Mona"}}]}`))
+	if len(details.Commits) != 1 || details.Commits[0] != (EventCommit{SHA: "abc", Message: "fix", Author: "Mona"}) {
+		t.Errorf("unexpected commits: %+v", details.Commits)
+	}
+}
+
+func TestFetchPullRequestsMergesSearches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// The same PR is both authored and assigned; it is listed once, as authored
+		w.Write([]byte(`{"data":{
+			"authored":{"nodes":[
+				{"number":1,"title":"Fix","url":"https://github.com/o/a/pull/1","createdAt":"2025-03-01T10:00:00Z","updatedAt":"2025-03-01T10:00:00Z",
+				 "reviewDecision":"APPROVED","repository":{"nameWithOwner":"o/a"},
+				 "commits":{"nodes":[{"commit":{"statusCheckRollup":{"state":"SUCCESS"}}}]}}
+			]},
+			"reviewRequested":{"nodes":[
+				{"number":7,"title":"Feature","url":"https://github.com/o/b/pull/7","createdAt":"2025-03-02T10:00:00Z","updatedAt":"2025-03-03T10:00:00Z",
+				 "isDraft":true,"repository":{"nameWithOwner":"o/b"},"commits":{"nodes":[{"commit":{"statusCheckRollup":null}}]}},
+				{}
+			]},
+			"assigned":{"nodes":[
+				{"number":1,"title":"Fix","url":"https://github.com/o/a/pull/1","createdAt":"2025-03-01T10:00:00Z","updatedAt":"2025-03-01T10:00:00Z",
+				 "repository":{"nameWithOwner":"o/a"},"commits":{"nodes":[]}}
+			]}
+		}}`))
+	}))
+	defer server.Close()
+
+	client := NewGitHubClientWithHTTP(server.Client(), server.URL, server.URL+"/graphql")
+	prs, err := client.FetchPullRequests("octocat")
+	if err != nil {
+		t.Fatalf("FetchPullRequests returned error: %v", err)
+	}
+	if len(prs) != 2 {
+		t.Fatalf("expected 2 unique pull requests, got %d: %+v", len(prs), prs)
+	}
+	// Most recently updated first
+	if prs[0].Number != 7 || prs[0].Role != "reviewer" || !prs[0].IsDraft || prs[0].CIStatus != "" {
+		t.Errorf("unexpected first pull request: %+v", prs[0])
+	}
+	if prs[1].Repo != "o/a" || prs[1].Role != "author" || prs[1].CIStatus != "SUCCESS" || prs[1].ReviewDecision != "APPROVED" {
+		t.Errorf("unexpected second pull request: %+v", prs[1])
+	}
+}
+
+func TestFetchRepoDetailCombinesGraphQLAndREST(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/graphql":
+			w.Write([]byte(`{"data":{"repository":{
+				"nameWithOwner":"o/a","description":"A tool","url":"https://github.com/o/a",
+				"stargazerCount":12,"forkCount":3,"watchers":{"totalCount":4},
+				"pullRequests":{"totalCount":2},"issues":{"totalCount":5},
+				"licenseInfo":{"name":"Other","spdxId":"NOASSERTION"},
+				"repositoryTopics":{"nodes":[{"topic":{"name":"cli"}}]},
+				"latestRelease":{"name":"First","tagName":"v1.0.0","url":"https://github.com/o/a/releases/v1.0.0","publishedAt":"2025-03-01T10:00:00Z"},
+				"languages":{"totalSize":400,"edges":[{"size":300,"node":{"name":"Go","color":"#00ADD8"}},{"size":100,"node":{"name":"Shell","color":""}}]}
+			}}}`))
+		case "/repos/o/a/readme":
+			if r.Header.Get("Accept") != "application/vnd.github.raw+json" {
+				t.Errorf("README requested as %q", r.Header.Get("Accept"))
+			}
+			w.Write([]byte("# a\n"))
+		case "/repos/o/a/stats/commit_activity":
+			// Statistics are still being computed
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{}`))
+		case "/repos/o/a/contributors":
+			// Too many contributors to list
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewGitHubClientWithHTTP(server.Client(), server.URL, server.URL+"/graphql")
+	detail, err := client.FetchRepoDetail("o/a")
+	if err != nil {
+		t.Fatalf("FetchRepoDetail returned error: %v", err)
+	}
+	if detail.Stars != 12 || detail.Watchers != 4 || detail.OpenPRs != 2 || detail.OpenIssues != 5 {
+		t.Errorf("unexpected counts: %+v", detail)
+	}
+	// Unidentified licenses fall back to the license name
+	if detail.License != "Other" || len(detail.Topics) != 1 || detail.LatestRelease == nil || detail.LatestRelease.Tag != "v1.0.0" {
+		t.Errorf("unexpected metadata: %+v", detail)
+	}
+	if len(detail.Languages) != 2 || detail.Languages[0].Percentage != 0.75 || detail.Languages[1].Color == "" {
+		t.Errorf("unexpected languages: %+v", detail.Languages)
+	}
+	if detail.Readme != "# a\n" || !detail.CommitsQueued || detail.CommitWeeks != nil {
+		t.Errorf("unexpected README or commit activity: %q %v %v", detail.Readme, detail.CommitsQueued, detail.CommitWeeks)
+	}
+	// Optional sections fail on their own
+	if detail.ContributorsErr == nil || detail.Contributors != nil || detail.ReadmeErr != nil || detail.CommitsErr != nil {
+		t.Errorf("unexpected section errors: readme %v, commits %v, contributors %v",
+			detail.ReadmeErr, detail.CommitsErr, detail.ContributorsErr)
+	}
+
+	if _, err := client.FetchRepoDetail("no-slash"); err == nil {
+		t.Error("expected error for a name without an owner")
+	}
+}
+
+func TestFetchOrgProfileAndContributions(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/acme":
+			w.Write([]byte(`{"login":"acme","name":"Acme","description":"Roadrunner traps","blog":"https://acme.example","public_repos":12,"followers":7,"created_at":"2015-04-01T00:00:00Z"}`))
+		case "/orgs/acme/public_members":
+			if r.URL.Query().Get("page") == "" {
+				w.Header().Set("Link", `<`+server.URL+`/orgs/acme/public_members?per_page=100&page=2>; rel="next"`)
+				w.Write([]byte(`[{"login":"alice"}]`))
+				return
+			}
+			w.Write([]byte(`[{"login":"bob"}]`))
+		case "/graphql":
+			var req struct {
+				Variables struct {
+					Username string `json:"username"`
+				} `json:"variables"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Variables.Username == "ghost" {
+				w.Write([]byte(`{"data":{"user":null},"errors":[{"message":"Could not resolve to a User"}]}`))
+				return
+			}
+			days := `{"contributionCount":2,"contributionLevel":"FIRST_QUARTILE","date":"2025-03-01"},
+				{"contributionCount":1,"contributionLevel":"FIRST_QUARTILE","date":"2025-03-02"}`
+			years := `[2025]`
+			if req.Variables.Username == "bob" {
+				days = `{"contributionCount":5,"contributionLevel":"FOURTH_QUARTILE","date":"2025-03-02"},
+					{"contributionCount":3,"contributionLevel":"SECOND_QUARTILE","date":"2025-02-28"}`
+				years = `[2025,2024]`
+			}
+			w.Write([]byte(`{"data":{"user":{"contributionsCollection":{
+				"contributionYears":` + years + `,"totalCommitContributions":4,
+				"contributionCalendar":{"weeks":[{"contributionDays":[` + days + `]}]}
+			}}}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewGitHubClientWithHTTP(server.Client(), server.URL, server.URL+"/graphql")
+
+	profile, err := client.FetchOrgProfile("acme")
+	if err != nil {
+		t.Fatalf("FetchOrgProfile returned error: %v", err)
+	}
+	if !profile.IsOrg || len(profile.Members) != 2 || profile.Bio != "Roadrunner traps" || profile.PublicRepos != 12 {
+		t.Errorf("unexpected org profile: %+v", profile)
+	}
+	if profile.Blog != "https://acme.example" || profile.Company != "" {
+		t.Errorf("website %q should not be the company %q", profile.Blog, profile.Company)
+	}
+
+	// A member whose calendar fails is skipped, not fatal
+	calendar, err := client.FetchOrgContributions(append(profile.Members, "ghost"), 0)
+	if err != nil {
+		t.Fatalf("FetchOrgContributions returned error: %v", err)
+	}
+	if calendar.Members != 2 || calendar.Skipped != 1 || calendar.Totals.Commits != 8 || len(calendar.Years) != 2 || calendar.Years[0] != 2025 {
+		t.Errorf("unexpected calendar: %+v", calendar)
+	}
+	// Days are summed by date, oldest first, and leave levels to the graph
+	want := []int{3, 2, 6}
+	if len(calendar.Days) != len(want) {
+		t.Fatalf("expected %d days, got %+v", len(want), calendar.Days)
+	}
+	for i, day := range calendar.Days {
+		if day.Count != want[i] || day.Level != 0 {
+			t.Errorf("day %s = %d (level %d), want %d", day.Date.Format("2006-01-02"), day.Count, day.Level, want[i])
+		}
+	}
+
+	if _, err := client.FetchOrgContributions([]string{"ghost"}, 0); err == nil {
+		t.Error("expected error when every member fails")
+	}
+	if _, err := client.FetchOrgProfile("ghost"); err == nil {
+		t.Error("expected error for unknown organization")
+	}
+}
+
+func TestFetchIssuesReturnsEverySearch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{
+			"assigned":{"issueCount":41,"nodes":[
+				{"number":3,"title":"Crash","url":"https://github.com/o/a/issues/3","updatedAt":"2025-03-01T10:00:00Z",
+				 "repository":{"nameWithOwner":"o/a"},"labels":{"nodes":[{"name":"bug","color":"d73a4a"}]},
+				 "milestone":{"title":"v1.0"},"comments":{"totalCount":5}},
+				{}
+			]},
+			"authored":{"issueCount":0,"nodes":[]},
+			"mentioned":{"issueCount":1,"nodes":[
+				{"number":9,"title":"Question","url":"https://github.com/o/b/issues/9","updatedAt":"2025-03-02T10:00:00Z",
+				 "repository":{"nameWithOwner":"o/b"},"labels":{"nodes":[]},"milestone":null,"comments":{"totalCount":0}}
+			]}
+		}}`))
+	}))
+	defer server.Close()
+
+	client := NewGitHubClientWithHTTP(server.Client(), server.URL, server.URL+"/graphql")
+	results, err := client.FetchIssues("octocat")
+	if err != nil {
+		t.Fatalf("FetchIssues returned error: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected one result per search, got %d", len(results))
+	}
+
+	assigned := results[IssuesAssigned]
+	if assigned.Total != 41 || len(assigned.Issues) != 1 {
+		t.Fatalf("unexpected assigned search: %+v", assigned)
+	}
+	issue := assigned.Issues[0]
+	if issue.Repo != "o/a" || issue.Milestone != "v1.0" || issue.Comments != 5 ||
+		len(issue.Labels) != 1 || issue.Labels[0].Color != "d73a4a" {
+		t.Errorf("unexpected issue: %+v", issue)
+	}
+	if results[IssuesAuthored].Total != 0 || results[IssuesMentioned].Issues[0].Milestone != "" {
+		t.Errorf("unexpected authored/mentioned searches: %+v", results)
+	}
+}
+
+func TestFetchNotificationsPollsConditionally(t *testing.T) {
+	const lastModified = "Mon, 03 Mar 2025 10:00:00 GMT"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Poll-Interval", "120")
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		w.Write([]byte(`[
+			{"id":"1","reason":"review_requested","unread":true,"updated_at":"2025-03-03T10:00:00Z",
+			 "subject":{"title":"Add cache","url":"https://api.github.com/repos/o/a/pulls/7","type":"PullRequest"},
+			 "repository":{"full_name":"o/a","html_url":"https://github.com/o/a"}}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewGitHubClientWithHTTP(server.Client(), server.URL, server.URL+"/graphql")
+	poll, err := client.FetchNotifications("")
+	if err != nil {
+		t.Fatalf("FetchNotifications returned error: %v", err)
+	}
+	if poll.NotModified || len(poll.Notifications) != 1 || poll.LastModified != lastModified || poll.PollInterval != 2*time.Minute {
+		t.Fatalf("unexpected first poll: %+v", poll)
+	}
+	if n := poll.Notifications[0]; n.URL != "https://github.com/o/a/pull/7" || n.Repo != "o/a" || !n.Unread {
+		t.Errorf("unexpected notification: %+v", n)
+	}
+
+	poll, err = client.FetchNotifications(poll.LastModified)
+	if err != nil {
+		t.Fatalf("conditional FetchNotifications returned error: %v", err)
+	}
+	if !poll.NotModified || poll.LastModified != lastModified {
+		t.Errorf("expected a not-modified poll keeping Last-Modified, got %+v", poll)
+	}
+}
+
+func TestNotificationThreadActions(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Method+" "+r.URL.Path)
+		if r.Method == "PATCH" {
+			w.WriteHeader(http.StatusResetContent)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewGitHubClientWithHTTP(server.Client(), server.URL, server.URL+"/graphql")
+	for _, act := range []func(string) error{client.MarkNotificationRead, client.MarkNotificationDone, client.UnsubscribeNotification} {
+		if err := act("42"); err != nil {
+			t.Fatalf("notification action returned error: %v", err)
+		}
+	}
+
+	want := []string{
+		"PATCH /notifications/threads/42",
+		"DELETE /notifications/threads/42",
+		"DELETE /notifications/threads/42/subscription",
+	}
+	for i := range want {
+		if i >= len(got) || got[i] != want[i] {
+			t.Fatalf("requests = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestNotificationWebURL(t *testing.T) {
+	repo := "https://github.com/o/a"
+	tests := map[string]string{
+		"https://api.github.com/repos/o/a/issues/3":     repo + "/issues/3",
+		"https://api.github.com/repos/o/a/commits/abc1": repo + "/commit/abc1",
+		"https://api.github.com/repos/o/a/releases/99":  repo,
+		"": repo,
+	}
+	for subject, want := range tests {
+		if got := notificationWebURL(repo, subject); got != want {
+			t.Errorf("notificationWebURL(%q) = %q, want %q", subject, got, want)
+		}
+	}
+}
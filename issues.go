@@ -0,0 +1,212 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// issueColumns defines the Issues table columns
+// Milestone and labels are dropped first on narrow terminals
+func issueColumns() []tableColumn {
+	return []tableColumn{
+		{title: "Issue", min: 10, max: 30, priority: 5},
+		{title: "Title", min: 12, priority: 6},
+		{title: "Labels", min: 8, max: 24, priority: 2},
+		{title: "Milestone", short: "Mile", min: 6, max: 14, priority: 1},
+		{title: "Comments", short: "Cmt", min: 3, max: 8, priority: 3},
+		{title: "Updated", short: "Upd", min: 4, max: 7, priority: 4},
+	}
+}
+
+// currentIssues returns the selected search's issues
+func (m Model) currentIssues() []Issue {
+	if int(m.issueQuery) >= len(m.issues) {
+		return nil
+	}
+	return m.issues[m.issueQuery].Issues
+}
+
+// renderIssues renders the issues column with its query selector
+func (m Model) renderIssues(width, height int) string {
+	hMargin := 1
+	if width > 100 {
+		hMargin = 2
+	}
+
+	total := 0
+	if int(m.issueQuery) < len(m.issues) {
+		total = m.issues[m.issueQuery].Total
+	}
+	title := m.columnTitle(focusIssues, fmt.Sprintf("Issues (%d)", total))
+
+	var content string
+	switch {
+	case !m.ready || (m.loadingIssues && m.issues == nil):
+		content = lipgloss.JoinVertical(lipgloss.Left, title, "", labelStyle.Render("Loading..."))
+	case m.issueErr != nil && m.issues == nil:
+		content = lipgloss.JoinVertical(lipgloss.Left, title, "", errorStyle.Render(wrapLine(m.issueErr.Error(), columnContentWidth(width))))
+	default:
+		columns := issueColumns()
+		widths := layoutColumns(columns, columnContentWidth(width))
+
+		headerStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(CurrentTheme.Blue)).
+			Bold(true)
+		borderStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(CurrentTheme.Gray))
+
+		header := renderTableHeader(columns, widths, headerStyle, borderStyle)
+		separator := renderTableSeparator(widths, borderStyle)
+
+		content = lipgloss.JoinVertical(lipgloss.Left, title, m.renderIssueSelector(), header, separator, m.issueViewport.View())
+	}
+
+	return lipgloss.NewStyle().
+		PaddingLeft(hMargin).
+		PaddingRight(hMargin).
+		Render(content)
+}
+
+// renderIssueSelector renders the query choices with their counts, e.g. "assigned 4 · authored 12"
+func (m Model) renderIssueSelector() string {
+	selected := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(CurrentTheme.Yellow)).
+		Bold(true)
+
+	var parts []string
+	for i, search := range m.issues {
+		text := fmt.Sprintf("%s %d", IssueQuery(i), search.Total)
+		if IssueQuery(i) == m.issueQuery {
+			parts = append(parts, selected.Render(text))
+		} else {
+			parts = append(parts, dimStyle.Render(text))
+		}
+	}
+	return strings.Join(parts, dimStyle.Render(" · "))
+}
+
+// issueLines renders the rows of the selected issue search
+func (m Model) issueLines() []string {
+	issues := m.currentIssues()
+	if len(issues) == 0 {
+		return []string{labelStyle.Render("No open issues " + m.issueQuery.String())}
+	}
+
+	widths := layoutColumns(issueColumns(), m.issueViewport.Width)
+
+	var rows []string
+	for i, issue := range issues {
+		styles := []lipgloss.Style{
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Green)),
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Foreground)),
+			lipgloss.NewStyle(),
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Purple)),
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Gray)),
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Cyan)),
+		}
+		dividerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Gray))
+		if i == m.issueCursor {
+			bg := lipgloss.Color(CurrentTheme.Subtle)
+			for j := range styles {
+				styles[j] = styles[j].Background(bg)
+			}
+			dividerStyle = dividerStyle.Background(bg)
+		}
+
+		comments := ""
+		if issue.Comments > 0 {
+			comments = strconv.Itoa(issue.Comments)
+		}
+
+		rows = append(rows, renderTableRow(
+			[]string{
+				fmt.Sprintf("%s#%d", issue.Repo, issue.Number),
+				issue.Title,
+				renderIssueLabels(issue.Labels),
+				issue.Milestone,
+				comments,
+				formatTimeAgo(issue.UpdatedAt, true),
+			},
+			widths,
+			func(col int) lipgloss.Style { return styles[col] },
+			dividerStyle,
+		))
+	}
+	return rows
+}
+
+// renderIssueLabels renders label names on their GitHub label colors
+func renderIssueLabels(labels []IssueLabel) string {
+	var parts []string
+	for _, label := range labels {
+		parts = append(parts, lipgloss.NewStyle().
+			Background(lipgloss.Color("#"+label.Color)).
+			Foreground(lipgloss.Color(labelTextColor(label.Color))).
+			Render(label.Name))
+	}
+	return strings.Join(parts, " ")
+}
+
+// labelTextColor picks black or white text for legibility on a hex background
+func labelTextColor(hex string) string {
+	value, err := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+	if err != nil || len(strings.TrimPrefix(hex, "#")) != 6 {
+		return "#000000"
+	}
+	r, g, b := float64(value>>16&0xff), float64(value>>8&0xff), float64(value&0xff)
+	// Perceived brightness, as GitHub uses for its own label text
+	if (r*299+g*587+b*114)/1000 > 150 {
+		return "#000000"
+	}
+	return "#ffffff"
+}
+
+// cycleIssueQuery switches the Issues column to the next search
+func (m *Model) cycleIssueQuery() {
+	m.issueQuery = m.issueQuery.Next()
+	m.issueCursor = 0
+	m.refreshIssueViewport()
+	m.issueViewport.GotoTop()
+}
+
+// moveIssueCursor moves the issue cursor, clamped to the selected search's issues
+func (m *Model) moveIssueCursor(delta int) {
+	count := len(m.currentIssues())
+	if count == 0 {
+		return
+	}
+	m.issueCursor += delta
+	if m.issueCursor < 0 {
+		m.issueCursor = 0
+	}
+	if m.issueCursor >= count {
+		m.issueCursor = count - 1
+	}
+	m.refreshIssueViewport()
+}
+
+// selectedIssue returns the issue under the cursor
+func (m Model) selectedIssue() (Issue, bool) {
+	issues := m.currentIssues()
+	if m.issueCursor < 0 || m.issueCursor >= len(issues) {
+		return Issue{}, false
+	}
+	return issues[m.issueCursor], true
+}
+
+// refreshIssueViewport re-renders the issue rows and scrolls the cursor into view
+func (m *Model) refreshIssueViewport() {
+	if !m.ready {
+		return
+	}
+	m.issueViewport.SetContent(strings.Join(m.issueLines(), "\n"))
+
+	if m.issueCursor < m.issueViewport.YOffset {
+		m.issueViewport.SetYOffset(m.issueCursor)
+	} else if m.issueCursor >= m.issueViewport.YOffset+m.issueViewport.Height {
+		m.issueViewport.SetYOffset(m.issueCursor - m.issueViewport.Height + 1)
+	}
+}
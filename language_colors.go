@@ -0,0 +1,1838 @@
+// Code generated by scripts/genlanguages from linguist languages.yml (commit f101af52dce8); DO NOT EDIT.
+
+package main
+
+// linguistColors maps canonical linguist language names to their GitHub colors
+var linguistColors = map[string]string{
+	"1C Enterprise":                  "#814CCC",
+	"2-Dimensional Array":            "#38761D",
+	"4D":                             "#004289",
+	"ABAP":                           "#E8274B",
+	"ABAP CDS":                       "#555e25",
+	"AGS Script":                     "#B9D9FF",
+	"AIDL":                           "#34EB6B",
+	"AL":                             "#3AA2B5",
+	"AMPL":                           "#E6EFBB",
+	"ANTLR":                          "#9DC3FF",
+	"API Blueprint":                  "#2ACCA8",
+	"APL":                            "#5A8164",
+	"ASP.NET":                        "#9400ff",
+	"ATS":                            "#1ac620",
+	"ActionScript":                   "#882B0F",
+	"Ada":                            "#02f88c",
+	"Adblock Filter List":            "#800000",
+	"Adobe Font Metrics":             "#fa0f00",
+	"Agda":                           "#315665",
+	"Aiken":                          "#640ff8",
+	"Alloy":                          "#64C800",
+	"Alpine Abuild":                  "#0D597F",
+	"Altium Designer":                "#A89663",
+	"AngelScript":                    "#C7D7DC",
+	"Answer Set Programming":         "#A9CC29",
+	"Ant Build System":               "#A9157E",
+	"Antlers":                        "#ff269e",
+	"ApacheConf":                     "#d12127",
+	"Apex":                           "#1797c0",
+	"Apollo Guidance Computer":       "#0B3D91",
+	"AppleScript":                    "#101F1F",
+	"Arc":                            "#aa2afe",
+	"AsciiDoc":                       "#73a0c5",
+	"AspectJ":                        "#a957b0",
+	"Assembly":                       "#6E4C13",
+	"Astro":                          "#ff5a03",
+	"Asymptote":                      "#ff0000",
+	"Augeas":                         "#9CC134",
+	"AutoHotkey":                     "#6594b9",
+	"AutoIt":                         "#1C3552",
+	"Avro IDL":                       "#0040FF",
+	"Awk":                            "#c30e9b",
+	"B4X":                            "#00e4ff",
+	"BASIC":                          "#ff0000",
+	"BQN":                            "#2b7067",
+	"Ballerina":                      "#FF5000",
+	"Batchfile":                      "#C1F12E",
+	"Beef":                           "#a52f4e",
+	"Berry":                          "#15A13C",
+	"BibTeX":                         "#778899",
+	"Bicep":                          "#519aba",
+	"Bikeshed":                       "#5562ac",
+	"Bison":                          "#6A463F",
+	"BitBake":                        "#00bce4",
+	"Blade":                          "#f7523f",
+	"BlitzBasic":                     "#00FFAE",
+	"BlitzMax":                       "#cd6400",
+	"Bluespec":                       "#12223c",
+	"Bluespec BH":                    "#12223c",
+	"Boo":                            "#d4bec1",
+	"Boogie":                         "#c80fa0",
+	"Brainfuck":                      "#2F2530",
+	"BrighterScript":                 "#66AABB",
+	"Brightscript":                   "#662D91",
+	"Browserslist":                   "#ffd539",
+	"Bru":                            "#F4AA41",
+	"BuildStream":                    "#006bff",
+	"C":                              "#555555",
+	"C#":                             "#178600",
+	"C++":                            "#f34b7d",
+	"C3":                             "#2563eb",
+	"CAP CDS":                        "#0092d1",
+	"CLIPS":                          "#00A300",
+	"CMake":                          "#DA3434",
+	"COLLADA":                        "#F1A42B",
+	"CSON":                           "#244776",
+	"CSS":                            "#663399",
+	"CSV":                            "#237346",
+	"CUE":                            "#5886E1",
+	"CWeb":                           "#00007a",
+	"Cabal Config":                   "#483465",
+	"Caddyfile":                      "#22b638",
+	"Cadence":                        "#00ef8b",
+	"Cairo":                          "#ff4a48",
+	"Cairo Zero":                     "#ff4a48",
+	"CameLIGO":                       "#3be133",
+	"Cap'n Proto":                    "#c42727",
+	"Carbon":                         "#222222",
+	"Ceylon":                         "#dfa535",
+	"Chapel":                         "#8dc63f",
+	"ChucK":                          "#3f8000",
+	"Circom":                         "#707575",
+	"Cirru":                          "#ccccff",
+	"Clarion":                        "#db901e",
+	"Clarity":                        "#5546ff",
+	"Classic ASP":                    "#6a40fd",
+	"Clean":                          "#3F85AF",
+	"Click":                          "#E4E6F3",
+	"Clojure":                        "#db5855",
+	"Closure Templates":              "#0d948f",
+	"Cloud Firestore Security Rules": "#FFA000",
+	"Clue":                           "#0009b5",
+	"CodeQL":                         "#140f46",
+	"CoffeeScript":                   "#244776",
+	"ColdFusion":                     "#ed2cd6",
+	"ColdFusion CFC":                 "#ed2cd6",
+	"Common Lisp":                    "#3fb68b",
+	"Common Workflow Language":       "#B5314C",
+	"Component Pascal":               "#B0CE4E",
+	"Cooklang":                       "#E15A29",
+	"Crystal":                        "#000100",
+	"Csound":                         "#1a1a1a",
+	"Csound Document":                "#1a1a1a",
+	"Csound Score":                   "#1a1a1a",
+	"Cuda":                           "#3A4E3A",
+	"Curry":                          "#531242",
+	"Cylc":                           "#00b3fd",
+	"Cypher":                         "#34c0eb",
+	"Cython":                         "#fedf5b",
+	"D":                              "#ba595e",
+	"D2":                             "#526ee8",
+	"DM":                             "#447265",
+	"Dafny":                          "#FFEC25",
+	"Darcs Patch":                    "#8eff23",
+	"Dart":                           "#00B4AB",
+	"Daslang":                        "#d3d3d3",
+	"DataWeave":                      "#003a52",
+	"Debian Package Control File":    "#D70751",
+	"DenizenScript":                  "#FBEE96",
+	"Dhall":                          "#dfafff",
+	"DirectX 3D File":                "#aace60",
+	"Dockerfile":                     "#384d54",
+	"Dogescript":                     "#cca760",
+	"Dotenv":                         "#e5d559",
+	"Dune":                           "#89421e",
+	"Dylan":                          "#6c616e",
+	"E":                              "#ccce35",
+	"ECL":                            "#8a1267",
+	"ECLiPSe":                        "#001d9d",
+	"EJS":                            "#a91e50",
+	"EQ":                             "#a78649",
+	"Earthly":                        "#2af0ff",
+	"Easybuild":                      "#069406",
+	"Ecere Projects":                 "#913960",
+	"Ecmarkup":                       "#eb8131",
+	"Edge":                           "#0dffe0",
+	"EdgeQL":                         "#31A7FF",
+	"EditorConfig":                   "#fff1f2",
+	"Eiffel":                         "#4d6977",
+	"Elixir":                         "#6e4a7e",
+	"Elm":                            "#60B5CC",
+	"Elvish":                         "#55BB55",
+	"Elvish Transcript":              "#55BB55",
+	"Emacs Lisp":                     "#c065db",
+	"EmberScript":                    "#FFF4F3",
+	"Erlang":                         "#B83998",
+	"Euphoria":                       "#FF790B",
+	"F#":                             "#b845fc",
+	"F*":                             "#572e30",
+	"FIGlet Font":                    "#FFDDBB",
+	"FIRRTL":                         "#2f632f",
+	"FLUX":                           "#88ccff",
+	"Factor":                         "#636746",
+	"Fancy":                          "#7b9db4",
+	"Fantom":                         "#14253c",
+	"Faust":                          "#c37240",
+	"Fennel":                         "#fff3d7",
+	"Filebench WML":                  "#F6B900",
+	"Flix":                           "#d44a45",
+	"Fluent":                         "#ffcc33",
+	"Forth":                          "#341708",
+	"Fortran":                        "#4d41b1",
+	"Fortran Free Form":              "#4d41b1",
+	"FreeBASIC":                      "#141AC9",
+	"FreeMarker":                     "#0050b2",
+	"Frege":                          "#00cafe",
+	"Futhark":                        "#5f021f",
+	"G-code":                         "#D08CF2",
+	"GAML":                           "#FFC766",
+	"GAMS":                           "#f49a22",
+	"GAP":                            "#0000cc",
+	"GCC Machine Description":        "#FFCFAB",
+	"GDScript":                       "#355570",
+	"GDShader":                       "#478CBF",
+	"GEDCOM":                         "#003058",
+	"GLSL":                           "#5686a5",
+	"GSC":                            "#FF6800",
+	"Game Maker Language":            "#71b417",
+	"Gemfile.lock":                   "#701516",
+	"Gemini":                         "#ff6900",
+	"Genero 4gl":                     "#63408e",
+	"Genero per":                     "#d8df39",
+	"Genie":                          "#fb855d",
+	"Genshi":                         "#951531",
+	"Gentoo Ebuild":                  "#9400ff",
+	"Gentoo Eclass":                  "#9400ff",
+	"Gerber Image":                   "#d20b00",
+	"Gherkin":                        "#5B2063",
+	"Git Attributes":                 "#F44D27",
+	"Git Config":                     "#F44D27",
+	"Git Revision List":              "#F44D27",
+	"Gleam":                          "#ffaff3",
+	"Glimmer JS":                     "#F5835F",
+	"Glimmer TS":                     "#3178c6",
+	"Glyph":                          "#c1ac7f",
+	"Gnuplot":                        "#f0a9f0",
+	"Go":                             "#00ADD8",
+	"Go Checksums":                   "#00ADD8",
+	"Go Module":                      "#00ADD8",
+	"Go Workspace":                   "#00ADD8",
+	"Godot Resource":                 "#355570",
+	"Golo":                           "#88562A",
+	"Gosu":                           "#82937f",
+	"Grace":                          "#615f8b",
+	"Gradle":                         "#02303a",
+	"Gradle Kotlin DSL":              "#02303a",
+	"Grammatical Framework":          "#ff0000",
+	"GraphQL":                        "#e10098",
+	"Graphviz (DOT)":                 "#2596be",
+	"Groovy":                         "#4298b8",
+	"Groovy Server Pages":            "#4298b8",
+	"HAProxy":                        "#106da9",
+	"HCL":                            "#844FBA",
+	"HIP":                            "#4F3A4F",
+	"HLSL":                           "#aace60",
+	"HOCON":                          "#9ff8ee",
+	"HTML":                           "#e34c26",
+	"HTML+ECR":                       "#2e1052",
+	"HTML+EEX":                       "#6e4a7e",
+	"HTML+ERB":                       "#701516",
+	"HTML+PHP":                       "#4f5d95",
+	"HTML+Razor":                     "#512be4",
+	"HTTP":                           "#005C9C",
+	"HXML":                           "#f68712",
+	"Hack":                           "#878787",
+	"Haml":                           "#ece2a9",
+	"Handlebars":                     "#f7931e",
+	"Harbour":                        "#0e60e3",
+	"Hare":                           "#9d7424",
+	"Haskell":                        "#5e5086",
+	"Haxe":                           "#df7900",
+	"HiveQL":                         "#dce200",
+	"HolyC":                          "#ffefaf",
+	"Hosts File":                     "#308888",
+	"Hy":                             "#7790B2",
+	"IDL":                            "#a3522f",
+	"IGOR Pro":                       "#0000cc",
+	"INI":                            "#d1dbe0",
+	"ISPC":                           "#2D68B1",
+	"Idris":                          "#b30000",
+	"Ignore List":                    "#000000",
+	"ImageJ Macro":                   "#99AAFF",
+	"Imba":                           "#16cec6",
+	"Inno Setup":                     "#264b99",
+	"Io":                             "#a9188d",
+	"Ioke":                           "#078193",
+	"Isabelle":                       "#FEFE00",
+	"Isabelle ROOT":                  "#FEFE00",
+	"J":                              "#9EEDFF",
+	"JAR Manifest":                   "#b07219",
+	"JCL":                            "#d90e09",
+	"JFlex":                          "#DBCA00",
+	"JSON":                           "#292929",
+	"JSON with Comments":             "#292929",
+	"JSON5":                          "#267CB9",
+	"JSONLD":                         "#0c479c",
+	"JSONiq":                         "#40d47e",
+	"Jai":                            "#ab8b4b",
+	"Janet":                          "#0886a5",
+	"Jasmin":                         "#d03600",
+	"Java":                           "#b07219",
+	"Java Properties":                "#2A6277",
+	"Java Server Pages":              "#2A6277",
+	"Java Template Engine":           "#2A6277",
+	"JavaScript":                     "#f1e05a",
+	"JavaScript+ERB":                 "#f1e05a",
+	"Jest Snapshot":                  "#15c213",
+	"JetBrains MPS":                  "#21D789",
+	"Jinja":                          "#a52a22",
+	"Jison":                          "#56b3cb",
+	"Jison Lex":                      "#56b3cb",
+	"Jolie":                          "#843179",
+	"Jsonnet":                        "#0064bd",
+	"Julia":                          "#a270ba",
+	"Julia REPL":                     "#a270ba",
+	"Jupyter Notebook":               "#DA5B0B",
+	"Just":                           "#384d54",
+	"KDL":                            "#ffb3b3",
+	"KRL":                            "#28430A",
+	"Kaitai Struct":                  "#773b37",
+	"KakouneScript":                  "#6f8042",
+	"KerboScript":                    "#41adf0",
+	"KiCad Layout":                   "#2f4aab",
+	"KiCad Legacy Layout":            "#2f4aab",
+	"KiCad Schematic":                "#2f4aab",
+	"KoLMafia ASH":                   "#B9D9B9",
+	"Koka":                           "#215166",
+	"Kotlin":                         "#A97BFF",
+	"LFE":                            "#4C3023",
+	"LLVM":                           "#185619",
+	"LOLCODE":                        "#cc9900",
+	"LSL":                            "#3d9970",
+	"LabVIEW":                        "#fede06",
+	"Lark":                           "#2980B9",
+	"Lasso":                          "#999999",
+	"Latte":                          "#f2a542",
+	"Leo":                            "#C4FFC2",
+	"Less":                           "#1d365d",
+	"Lex":                            "#DBCA00",
+	"LigoLANG":                       "#0e74ff",
+	"LilyPond":                       "#9ccc7c",
+	"Liquid":                         "#67b8de",
+	"Literate Agda":                  "#315665",
+	"Literate CoffeeScript":          "#244776",
+	"Literate Haskell":               "#5e5086",
+	"LiveCode Script":                "#0c5ba5",
+	"LiveScript":                     "#499886",
+	"Logtalk":                        "#295b9a",
+	"LookML":                         "#652B81",
+	"Lua":                            "#000080",
+	"Luau":                           "#00A2FF",
+	"M3U":                            "#179C7D",
+	"MATLAB":                         "#e16737",
+	"MAXScript":                      "#00a6a6",
+	"MDX":                            "#fcb32c",
+	"MLIR":                           "#5EC8DB",
+	"MQL4":                           "#62A8D6",
+	"MQL5":                           "#4A76B8",
+	"MTML":                           "#b7e1f4",
+	"Macaulay2":                      "#d8ffff",
+	"Makefile":                       "#427819",
+	"Mako":                           "#7e858d",
+	"Markdown":                       "#083fa1",
+	"Marko":                          "#42bff2",
+	"Mask":                           "#f97732",
+	"Mathematica":                    "#dd1100",
+	"Max":                            "#c4a79c",
+	"Mercury":                        "#ff2b2b",
+	"Mermaid":                        "#ff3670",
+	"Meson":                          "#007800",
+	"Metal":                          "#8f14e9",
+	"MiniYAML":                       "#ff1111",
+	"MiniZinc":                       "#06a9e6",
+	"Mint":                           "#02b046",
+	"Mirah":                          "#c7a938",
+	"Modelica":                       "#de1d31",
+	"Modula-2":                       "#10253f",
+	"Modula-3":                       "#223388",
+	"Mojo":                           "#ff4c1f",
+	"Monkey C":                       "#8D6747",
+	"MoonBit":                        "#b92381",
+	"MoonScript":                     "#ff4585",
+	"Motoko":                         "#fbb03b",
+	"Motorola 68K Assembly":          "#005daa",
+	"Move":                           "#4a137a",
+	"Mustache":                       "#724b3b",
+	"NCL":                            "#28431f",
+	"NMODL":                          "#00356B",
+	"NPM Config":                     "#cb3837",
+	"NWScript":                       "#111522",
+	"Nasal":                          "#1d2c4e",
+	"Nearley":                        "#990000",
+	"Nemerle":                        "#3d3c6e",
+	"NetLinx":                        "#0aa0ff",
+	"NetLinx+ERB":                    "#747faa",
+	"NetLogo":                        "#ff6375",
+	"NewLisp":                        "#87AED7",
+	"Nextflow":                       "#3ac486",
+	"Nginx":                          "#009639",
+	"Nickel":                         "#E0C3FC",
+	"Nim":                            "#ffc200",
+	"Nit":                            "#009917",
+	"Nix":                            "#7e7eff",
+	"Noir":                           "#2f1f49",
+	"Nu":                             "#c9df40",
+	"NumPy":                          "#9C8AF9",
+	"Nunjucks":                       "#3d8137",
+	"Nushell":                        "#4E9906",
+	"OASv2-json":                     "#85ea2d",
+	"OASv2-yaml":                     "#85ea2d",
+	"OASv3-json":                     "#85ea2d",
+	"OASv3-yaml":                     "#85ea2d",
+	"OCaml":                          "#ef7a08",
+	"OMNeT++ MSG":                    "#a0e0a0",
+	"OMNeT++ NED":                    "#08607c",
+	"ObjectScript":                   "#424893",
+	"Objective-C":                    "#438eff",
+	"Objective-C++":                  "#6866fb",
+	"Objective-J":                    "#ff0c5a",
+	"Odin":                           "#60AFFE",
+	"Omgrofl":                        "#cabbff",
+	"Opal":                           "#f7ede0",
+	"Open Policy Agent":              "#7d9199",
+	"OpenAPI Specification v2":       "#85ea2d",
+	"OpenAPI Specification v3":       "#85ea2d",
+	"OpenCL":                         "#ed2e2d",
+	"OpenEdge ABL":                   "#5ce600",
+	"OpenQASM":                       "#AA70FF",
+	"OpenSCAD":                       "#e5cd45",
+	"Option List":                    "#476732",
+	"Org":                            "#77aa99",
+	"OverpassQL":                     "#cce2aa",
+	"Oxygene":                        "#cdd0e3",
+	"Oz":                             "#fab738",
+	"P4":                             "#7055b5",
+	"PDDL":                           "#0d00ff",
+	"PEG.js":                         "#234d6b",
+	"PHP":                            "#4F5D95",
+	"PLSQL":                          "#dad8d8",
+	"PLpgSQL":                        "#336790",
+	"POV-Ray SDL":                    "#6bac65",
+	"Pact":                           "#F7A8B8",
+	"Pan":                            "#cc0000",
+	"Papyrus":                        "#6600cc",
+	"Parrot":                         "#f3ca0a",
+	"Pascal":                         "#E3F171",
+	"Pawn":                           "#dbb284",
+	"Pep8":                           "#C76F5B",
+	"Perl":                           "#0298c3",
+	"PicoLisp":                       "#6067af",
+	"PigLatin":                       "#fcd7de",
+	"Pike":                           "#005390",
+	"Pip Requirements":               "#FFD343",
+	"Pkl":                            "#6b9543",
+	"PlantUML":                       "#fbbd16",
+	"PogoScript":                     "#d80074",
+	"Polar":                          "#ae81ff",
+	"Portugol":                       "#f8bd00",
+	"PostCSS":                        "#dc3a0c",
+	"PostScript":                     "#da291c",
+	"PowerBuilder":                   "#8f0f8d",
+	"PowerShell":                     "#012456",
+	"Praat":                          "#c8506d",
+	"Prisma":                         "#0c344b",
+	"Processing":                     "#0096D8",
+	"Procfile":                       "#3B2F63",
+	"Prolog":                         "#74283c",
+	"Promela":                        "#de0000",
+	"Propeller Spin":                 "#7fa2a7",
+	"Pug":                            "#a86454",
+	"Puppet":                         "#302B6D",
+	"PureBasic":                      "#5a6986",
+	"PureScript":                     "#1D222D",
+	"Pyret":                          "#ee1e10",
+	"Python":                         "#3572A5",
+	"Python console":                 "#3572A5",
+	"Python traceback":               "#3572A5",
+	"Q#":                             "#fed659",
+	"QML":                            "#44a51c",
+	"Qt Script":                      "#00b841",
+	"Quake":                          "#882233",
+	"QuakeC":                         "#975777",
+	"QuickBASIC":                     "#008080",
+	"R":                              "#198CE7",
+	"RAML":                           "#77d9fb",
+	"RBS":                            "#701516",
+	"RDoc":                           "#701516",
+	"REXX":                           "#d90e09",
+	"RMarkdown":                      "#198ce7",
+	"RON":                            "#a62c00",
+	"ROS Interface":                  "#22314e",
+	"RPGLE":                          "#2BDE21",
+	"RUNOFF":                         "#665a4e",
+	"Racket":                         "#3c5caa",
+	"Ragel":                          "#9d5200",
+	"Raku":                           "#0000fb",
+	"Rascal":                         "#fffaa0",
+	"ReScript":                       "#ed5051",
+	"Reason":                         "#ff5847",
+	"ReasonLIGO":                     "#ff5847",
+	"Rebol":                          "#358a5b",
+	"Record Jar":                     "#0673ba",
+	"Red":                            "#f50000",
+	"Regular Expression":             "#009a00",
+	"Ren'Py":                         "#ff7f7f",
+	"Rez":                            "#FFDAB3",
+	"Ring":                           "#2D54CB",
+	"Riot":                           "#A71E49",
+	"RobotFramework":                 "#00c0b5",
+	"Roc":                            "#7c38f5",
+	"Rocq Prover":                    "#d0b68c",
+	"Roff":                           "#ecdebe",
+	"Roff Manpage":                   "#ecdebe",
+	"Rouge":                          "#cc0088",
+	"RouterOS Script":                "#DE3941",
+	"Ruby":                           "#701516",
+	"Rust":                           "#dea584",
+	"SAS":                            "#B34936",
+	"SCSS":                           "#c6538c",
+	"SPARQL":                         "#0C4597",
+	"SQF":                            "#3F3F3F",
+	"SQL":                            "#e38c00",
+	"SQLPL":                          "#e38c00",
+	"SRecode Template":               "#348a34",
+	"STL":                            "#373b5e",
+	"SVG":                            "#ff9900",
+	"Sail":                           "#259dd5",
+	"SaltStack":                      "#646464",
+	"Sass":                           "#a53b70",
+	"Scala":                          "#c22d40",
+	"Scaml":                          "#bd181a",
+	"Scenic":                         "#fdc700",
+	"Scheme":                         "#1e4aec",
+	"Scilab":                         "#ca0f21",
+	"Self":                           "#0579aa",
+	"ShaderLab":                      "#222c37",
+	"Shell":                          "#89e051",
+	"ShellCheck Config":              "#cecfcb",
+	"Shen":                           "#120F14",
+	"Simple File Verification":       "#C9BFED",
+	"Singularity":                    "#64E6AD",
+	"Slang":                          "#1fbec9",
+	"Slash":                          "#007eff",
+	"Slice":                          "#003fa2",
+	"Slim":                           "#2b2b2b",
+	"Slint":                          "#2379F4",
+	"SmPL":                           "#c94949",
+	"Smalltalk":                      "#596706",
+	"Smarty":                         "#f0c040",
+	"Smithy":                         "#c44536",
+	"Snakemake":                      "#419179",
+	"Solidity":                       "#AA6746",
+	"SourcePawn":                     "#f69e1d",
+	"Squirrel":                       "#800000",
+	"Stan":                           "#b2011d",
+	"Standard ML":                    "#dc566d",
+	"Starlark":                       "#76d275",
+	"Stata":                          "#1a5f91",
+	"StringTemplate":                 "#3fb34f",
+	"Stylus":                         "#ff6347",
+	"SubRip Text":                    "#9e0101",
+	"SugarSS":                        "#2fcc9f",
+	"SuperCollider":                  "#46390b",
+	"Survex data":                    "#ffcc99",
+	"Svelte":                         "#ff3e00",
+	"Sway":                           "#00F58C",
+	"Sweave":                         "#198ce7",
+	"Swift":                          "#F05138",
+	"SystemVerilog":                  "#DAE1C2",
+	"TI Program":                     "#A0AA87",
+	"TL-Verilog":                     "#C40023",
+	"TLA":                            "#4b0079",
+	"TOML":                           "#9c4221",
+	"TSQL":                           "#e38c00",
+	"TSV":                            "#237346",
+	"TSX":                            "#3178c6",
+	"TXL":                            "#0178b8",
+	"Tact":                           "#48b5ff",
+	"Talon":                          "#333333",
+	"Tcl":                            "#e4cc98",
+	"TeX":                            "#3D6117",
+	"Terra":                          "#00004c",
+	"Terraform Template":             "#7b42bb",
+	"TextGrid":                       "#c8506d",
+	"TextMate Properties":            "#df66e4",
+	"Textile":                        "#ffe7ac",
+	"Thrift":                         "#D12127",
+	"Toit":                           "#c2c9fb",
+	"Tor Config":                     "#59316b",
+	"Tree-sitter Query":              "#8ea64c",
+	"Turing":                         "#cf142b",
+	"Twig":                           "#c1d026",
+	"TypeScript":                     "#3178c6",
+	"TypeSpec":                       "#4A3665",
+	"Typst":                          "#239dad",
+	"Unified Parallel C":             "#4e3617",
+	"Unity3D Asset":                  "#222c37",
+	"Uno":                            "#9933cc",
+	"UnrealScript":                   "#a54c4d",
+	"Untyped Plutus Core":            "#36adbd",
+	"UrWeb":                          "#ccccee",
+	"V":                              "#4f87c4",
+	"VBA":                            "#867db1",
+	"VBScript":                       "#15dcdc",
+	"VCL":                            "#148AA8",
+	"VHDL":                           "#adb2cb",
+	"Vala":                           "#a56de2",
+	"Valve Data Format":              "#f26025",
+	"Velocity Template Language":     "#507cff",
+	"Vento":                          "#ff0080",
+	"Verilog":                        "#b2b7f8",
+	"Vim Help File":                  "#199f4b",
+	"Vim Script":                     "#199f4b",
+	"Vim Snippet":                    "#199f4b",
+	"Visual Basic .NET":              "#945db7",
+	"Visual Basic 6.0":               "#2c6353",
+	"Volt":                           "#1F1F1F",
+	"Vue":                            "#41b883",
+	"Vyper":                          "#9F4CF2",
+	"WDL":                            "#42f1f4",
+	"WGSL":                           "#1a5e9a",
+	"Web Ontology Language":          "#5b70bd",
+	"WebAssembly":                    "#04133b",
+	"WebAssembly Interface Type":     "#6250e7",
+	"Whiley":                         "#d5c397",
+	"Wikitext":                       "#fc5757",
+	"Windows Registry Entries":       "#52d5ff",
+	"Witcher Script":                 "#ff0000",
+	"Wollok":                         "#a23738",
+	"World of Warcraft Addon Data":   "#f7e43f",
+	"Wren":                           "#383838",
+	"X10":                            "#4B6BEF",
+	"XC":                             "#99DA07",
+	"XML":                            "#0060ac",
+	"XML Property List":              "#0060ac",
+	"XQuery":                         "#5232e7",
+	"XSLT":                           "#EB8CEB",
+	"Xmake":                          "#22a079",
+	"Xojo":                           "#81bd41",
+	"Xonsh":                          "#285EEF",
+	"Xtend":                          "#24255d",
+	"YAML":                           "#cb171e",
+	"YARA":                           "#220000",
+	"YASnippet":                      "#32AB90",
+	"Yacc":                           "#4B6C4B",
+	"Yul":                            "#794932",
+	"ZAP":                            "#0d665e",
+	"ZIL":                            "#dc75e5",
+	"ZenScript":                      "#00BCD1",
+	"Zephir":                         "#118f9e",
+	"Zig":                            "#ec915c",
+	"Zimpl":                          "#d67711",
+	"Zmodel":                         "#ff7100",
+	"crontab":                        "#ead7ac",
+	"eC":                             "#913960",
+	"fish":                           "#4aae47",
+	"hoon":                           "#00b171",
+	"iCalendar":                      "#ec564c",
+	"jq":                             "#c7254e",
+	"kvlang":                         "#1da6e0",
+	"mIRC Script":                    "#3d57c3",
+	"mcfunction":                     "#E22837",
+	"mdsvex":                         "#5f9ea0",
+	"mupad":                          "#244963",
+	"nanorc":                         "#2d004d",
+	"nesC":                           "#94B0C7",
+	"ooc":                            "#b0b77e",
+	"q":                              "#0040cd",
+	"reStructuredText":               "#141414",
+	"sed":                            "#64b970",
+	"templ":                          "#66D0DD",
+	"vCard":                          "#ee2647",
+	"wisp":                           "#7582D1",
+	"xBase":                          "#403a40",
+}
+
+// linguistAliases maps lowercased names and aliases to canonical linguist language names
+var linguistAliases = map[string]string{
+	"1c enterprise":                      "1C Enterprise",
+	"2-dimensional array":                "2-Dimensional Array",
+	"4d":                                 "4D",
+	"abap":                               "ABAP",
+	"abap cds":                           "ABAP CDS",
+	"abl":                                "OpenEdge ABL",
+	"abnf":                               "ABNF",
+	"abuild":                             "Alpine Abuild",
+	"acfm":                               "Adobe Font Metrics",
+	"ackrc":                              "Option List",
+	"aconf":                              "ApacheConf",
+	"actionscript":                       "ActionScript",
+	"actionscript 3":                     "ActionScript",
+	"actionscript3":                      "ActionScript",
+	"ad block":                           "Adblock Filter List",
+	"ad block filters":                   "Adblock Filter List",
+	"ada":                                "Ada",
+	"ada2005":                            "Ada",
+	"ada95":                              "Ada",
+	"adb":                                "Adblock Filter List",
+	"adblock":                            "Adblock Filter List",
+	"adblock filter list":                "Adblock Filter List",
+	"adobe composite font metrics":       "Adobe Font Metrics",
+	"adobe font metrics":                 "Adobe Font Metrics",
+	"adobe multiple font metrics":        "Adobe Font Metrics",
+	"advpl":                              "xBase",
+	"afdko":                              "OpenType Feature File",
+	"agda":                               "Agda",
+	"ags":                                "AGS Script",
+	"ags script":                         "AGS Script",
+	"ahk":                                "AutoHotkey",
+	"aidl":                               "AIDL",
+	"aiken":                              "Aiken",
+	"al":                                 "AL",
+	"alloy":                              "Alloy",
+	"alpine abuild":                      "Alpine Abuild",
+	"altium":                             "Altium Designer",
+	"altium designer":                    "Altium Designer",
+	"amfm":                               "Adobe Font Metrics",
+	"ampl":                               "AMPL",
+	"amusewiki":                          "Muse",
+	"angelscript":                        "AngelScript",
+	"answer set programming":             "Answer Set Programming",
+	"ant build system":                   "Ant Build System",
+	"antlers":                            "Antlers",
+	"antlr":                              "ANTLR",
+	"apache":                             "ApacheConf",
+	"apacheconf":                         "ApacheConf",
+	"apex":                               "Apex",
+	"api blueprint":                      "API Blueprint",
+	"apkbuild":                           "Alpine Abuild",
+	"apl":                                "APL",
+	"apollo guidance computer":           "Apollo Guidance Computer",
+	"applescript":                        "AppleScript",
+	"arc":                                "Arc",
+	"arexx":                              "REXX",
+	"as3":                                "ActionScript",
+	"ascii stl":                          "STL",
+	"asciidoc":                           "AsciiDoc",
+	"asl":                                "ASL",
+	"asm":                                "Assembly",
+	"asn.1":                              "ASN.1",
+	"asp":                                "Classic ASP",
+	"asp.net":                            "ASP.NET",
+	"aspectj":                            "AspectJ",
+	"aspx":                               "ASP.NET",
+	"aspx-vb":                            "ASP.NET",
+	"assembly":                           "Assembly",
+	"astro":                              "Astro",
+	"asymptote":                          "Asymptote",
+	"ats":                                "ATS",
+	"ats2":                               "ATS",
+	"au3":                                "AutoIt",
+	"augeas":                             "Augeas",
+	"autoconf":                           "M4Sugar",
+	"autohotkey":                         "AutoHotkey",
+	"autoit":                             "AutoIt",
+	"autoit3":                            "AutoIt",
+	"autoitscript":                       "AutoIt",
+	"avro idl":                           "Avro IDL",
+	"awk":                                "Awk",
+	"b3d":                                "BlitzBasic",
+	"b4x":                                "B4X",
+	"ballerina":                          "Ballerina",
+	"bash":                               "Shell",
+	"bash session":                       "ShellSession",
+	"basic":                              "BASIC",
+	"basic for android":                  "B4X",
+	"bat":                                "Batchfile",
+	"batch":                              "Batchfile",
+	"batchfile":                          "Batchfile",
+	"bazel":                              "Starlark",
+	"be":                                 "Berry",
+	"beef":                               "Beef",
+	"befunge":                            "Befunge",
+	"berry":                              "Berry",
+	"bh":                                 "Bluespec BH",
+	"bibtex":                             "BibTeX",
+	"bibtex style":                       "BibTeX Style",
+	"bicep":                              "Bicep",
+	"bikeshed":                           "Bikeshed",
+	"bison":                              "Bison",
+	"bitbake":                            "BitBake",
+	"blade":                              "Blade",
+	"blitz3d":                            "BlitzBasic",
+	"blitzbasic":                         "BlitzBasic",
+	"blitzmax":                           "BlitzMax",
+	"blitzplus":                          "BlitzBasic",
+	"bluespec":                           "Bluespec",
+	"bluespec bh":                        "Bluespec BH",
+	"bluespec bsv":                       "Bluespec",
+	"bluespec classic":                   "Bluespec BH",
+	"bmax":                               "BlitzMax",
+	"boo":                                "Boo",
+	"boogie":                             "Boogie",
+	"bplus":                              "BlitzBasic",
+	"bqn":                                "BQN",
+	"brainfuck":                          "Brainfuck",
+	"brighterscript":                     "BrighterScript",
+	"brightscript":                       "Brightscript",
+	"bro":                                "Zeek",
+	"browserslist":                       "Browserslist",
+	"bru":                                "Bru",
+	"bsdmake":                            "Makefile",
+	"bsv":                                "Bluespec",
+	"buildstream":                        "BuildStream",
+	"byond":                              "DM",
+	"bzl":                                "Starlark",
+	"c":                                  "C",
+	"c#":                                 "C#",
+	"c++":                                "C++",
+	"c++-objdump":                        "Cpp-ObjDump",
+	"c-objdump":                          "C-ObjDump",
+	"c2hs":                               "C2hs Haskell",
+	"c2hs haskell":                       "C2hs Haskell",
+	"c3":                                 "C3",
+	"cabal":                              "Cabal Config",
+	"cabal config":                       "Cabal Config",
+	"caddy":                              "Caddyfile",
+	"caddyfile":                          "Caddyfile",
+	"cadence":                            "Cadence",
+	"cairo":                              "Cairo",
+	"cairo zero":                         "Cairo Zero",
+	"cake":                               "C#",
+	"cakescript":                         "C#",
+	"cameligo":                           "CameLIGO",
+	"cap cds":                            "CAP CDS",
+	"cap'n proto":                        "Cap'n Proto",
+	"carbon":                             "Carbon",
+	"carto":                              "CartoCSS",
+	"cartocss":                           "CartoCSS",
+	"cds":                                "CAP CDS",
+	"ceylon":                             "Ceylon",
+	"cfc":                                "ColdFusion CFC",
+	"cfm":                                "ColdFusion",
+	"cfml":                               "ColdFusion",
+	"chapel":                             "Chapel",
+	"charity":                            "Charity",
+	"checksum":                           "Checksums",
+	"checksums":                          "Checksums",
+	"chpl":                               "Chapel",
+	"chuck":                              "ChucK",
+	"cil":                                "CIL",
+	"circom":                             "Circom",
+	"cirru":                              "Cirru",
+	"clarion":                            "Clarion",
+	"clarity":                            "Clarity",
+	"classic asp":                        "Classic ASP",
+	"classic qbasic":                     "QuickBASIC",
+	"classic quickbasic":                 "QuickBASIC",
+	"classic visual basic":               "Visual Basic 6.0",
+	"clean":                              "Clean",
+	"click":                              "Click",
+	"clipper":                            "xBase",
+	"clips":                              "CLIPS",
+	"clojure":                            "Clojure",
+	"closure templates":                  "Closure Templates",
+	"cloud firestore security rules":     "Cloud Firestore Security Rules",
+	"clue":                               "Clue",
+	"cmake":                              "CMake",
+	"cobol":                              "COBOL",
+	"coccinelle":                         "SmPL",
+	"codeowners":                         "CODEOWNERS",
+	"codeql":                             "CodeQL",
+	"coffee":                             "CoffeeScript",
+	"coffee-script":                      "CoffeeScript",
+	"coffeescript":                       "CoffeeScript",
+	"coldfusion":                         "ColdFusion",
+	"coldfusion cfc":                     "ColdFusion CFC",
+	"coldfusion html":                    "ColdFusion",
+	"collada":                            "COLLADA",
+	"common lisp":                        "Common Lisp",
+	"common workflow language":           "Common Workflow Language",
+	"component pascal":                   "Component Pascal",
+	"conll":                              "CoNLL-U",
+	"conll-u":                            "CoNLL-U",
+	"conll-x":                            "CoNLL-U",
+	"console":                            "ShellSession",
+	"containerfile":                      "Dockerfile",
+	"cooklang":                           "Cooklang",
+	"cool":                               "Cool",
+	"coq":                                "Rocq Prover",
+	"cperl":                              "Perl",
+	"cpp":                                "C++",
+	"cpp-objdump":                        "Cpp-ObjDump",
+	"creole":                             "Creole",
+	"cron":                               "crontab",
+	"cron table":                         "crontab",
+	"crontab":                            "crontab",
+	"crystal":                            "Crystal",
+	"csharp":                             "C#",
+	"cson":                               "CSON",
+	"csound":                             "Csound",
+	"csound document":                    "Csound Document",
+	"csound score":                       "Csound Score",
+	"csound-csd":                         "Csound Document",
+	"csound-orc":                         "Csound",
+	"csound-sco":                         "Csound Score",
+	"css":                                "CSS",
+	"csv":                                "CSV",
+	"cucumber":                           "Gherkin",
+	"cuda":                               "Cuda",
+	"cue":                                "CUE",
+	"cue sheet":                          "Cue Sheet",
+	"curl config":                        "cURL Config",
+	"curlrc":                             "cURL Config",
+	"curry":                              "Curry",
+	"cweb":                               "CWeb",
+	"cwl":                                "Common Workflow Language",
+	"cycript":                            "Cycript",
+	"cylc":                               "Cylc",
+	"cypher":                             "Cypher",
+	"cython":                             "Cython",
+	"d":                                  "D",
+	"d-objdump":                          "D-ObjDump",
+	"d2":                                 "D2",
+	"d2lang":                             "D2",
+	"dafny":                              "Dafny",
+	"darcs patch":                        "Darcs Patch",
+	"dart":                               "Dart",
+	"daslang":                            "Daslang",
+	"dataweave":                          "DataWeave",
+	"dcl":                                "DIGITAL Command Language",
+	"debian package control file":        "Debian Package Control File",
+	"delphi":                             "Pascal",
+	"denizenscript":                      "DenizenScript",
+	"desktop":                            "desktop",
+	"dhall":                              "Dhall",
+	"diff":                               "Diff",
+	"digital command language":           "DIGITAL Command Language",
+	"dircolors":                          "dircolors",
+	"directx 3d file":                    "DirectX 3D File",
+	"django":                             "Jinja",
+	"dlang":                              "D",
+	"dm":                                 "DM",
+	"dns zone":                           "DNS Zone",
+	"dockerfile":                         "Dockerfile",
+	"dogescript":                         "Dogescript",
+	"dosbatch":                           "Batchfile",
+	"dosini":                             "INI",
+	"dotenv":                             "Dotenv",
+	"dpatch":                             "Darcs Patch",
+	"dtrace":                             "DTrace",
+	"dtrace-script":                      "DTrace",
+	"dune":                               "Dune",
+	"dylan":                              "Dylan",
+	"e":                                  "E",
+	"e-mail":                             "E-mail",
+	"eagle":                              "Eagle",
+	"earthfile":                          "Earthly",
+	"earthly":                            "Earthly",
+	"easybuild":                          "Easybuild",
+	"ebnf":                               "EBNF",
+	"ec":                                 "eC",
+	"ecere projects":                     "Ecere Projects",
+	"ecl":                                "ECL",
+	"eclipse":                            "ECLiPSe",
+	"ecmarkdown":                         "Ecmarkup",
+	"ecmarkup":                           "Ecmarkup",
+	"ecr":                                "HTML+ECR",
+	"edge":                               "Edge",
+	"edgeql":                             "EdgeQL",
+	"editor-config":                      "EditorConfig",
+	"editorconfig":                       "EditorConfig",
+	"edje data collection":               "Edje Data Collection",
+	"edn":                                "edn",
+	"eeschema schematic":                 "KiCad Schematic",
+	"eex":                                "HTML+EEX",
+	"eiffel":                             "Eiffel",
+	"ejs":                                "EJS",
+	"electronic business card":           "vCard",
+	"elisp":                              "Emacs Lisp",
+	"elixir":                             "Elixir",
+	"elm":                                "Elm",
+	"elvish":                             "Elvish",
+	"elvish transcript":                  "Elvish Transcript",
+	"emacs":                              "Emacs Lisp",
+	"emacs lisp":                         "Emacs Lisp",
+	"emacs muse":                         "Muse",
+	"email":                              "E-mail",
+	"emberscript":                        "EmberScript",
+	"eml":                                "E-mail",
+	"envrc":                              "Shell",
+	"eq":                                 "EQ",
+	"erb":                                "HTML+ERB",
+	"erlang":                             "Erlang",
+	"esdl":                               "EdgeQL",
+	"euphoria":                           "Euphoria",
+	"f#":                                 "F#",
+	"f*":                                 "F*",
+	"factor":                             "Factor",
+	"fancy":                              "Fancy",
+	"fantom":                             "Fantom",
+	"faust":                              "Faust",
+	"fb":                                 "FreeBASIC",
+	"fennel":                             "Fennel",
+	"figfont":                            "FIGlet Font",
+	"figlet font":                        "FIGlet Font",
+	"filebench wml":                      "Filebench WML",
+	"filterscript":                       "Filterscript",
+	"firrtl":                             "FIRRTL",
+	"fish":                               "fish",
+	"flex":                               "Lex",
+	"flix":                               "Flix",
+	"fluent":                             "Fluent",
+	"flux":                               "FLUX",
+	"formatted":                          "Formatted",
+	"forth":                              "Forth",
+	"fortran":                            "Fortran",
+	"fortran free form":                  "Fortran Free Form",
+	"foxpro":                             "xBase",
+	"freebasic":                          "FreeBASIC",
+	"freemarker":                         "FreeMarker",
+	"frege":                              "Frege",
+	"fsharp":                             "F#",
+	"fstar":                              "F*",
+	"ftl":                                "FreeMarker",
+	"fundamental":                        "Text",
+	"futhark":                            "Futhark",
+	"g-code":                             "G-code",
+	"game maker language":                "Game Maker Language",
+	"gaml":                               "GAML",
+	"gams":                               "GAMS",
+	"gap":                                "GAP",
+	"gas":                                "Unix Assembly",
+	"gcc machine description":            "GCC Machine Description",
+	"gdb":                                "GDB",
+	"gdscript":                           "GDScript",
+	"gdshader":                           "GDShader",
+	"gedcom":                             "GEDCOM",
+	"gemfile.lock":                       "Gemfile.lock",
+	"gemini":                             "Gemini",
+	"gemtext":                            "Gemini",
+	"genero 4gl":                         "Genero 4gl",
+	"genero per":                         "Genero per",
+	"genie":                              "Genie",
+	"genshi":                             "Genshi",
+	"gentoo ebuild":                      "Gentoo Ebuild",
+	"gentoo eclass":                      "Gentoo Eclass",
+	"geojson":                            "JSON",
+	"gerber image":                       "Gerber Image",
+	"gettext catalog":                    "Gettext Catalog",
+	"gf":                                 "Grammatical Framework",
+	"gherkin":                            "Gherkin",
+	"git attributes":                     "Git Attributes",
+	"git blame ignore revs":              "Git Revision List",
+	"git config":                         "Git Config",
+	"git revision list":                  "Git Revision List",
+	"git-ignore":                         "Ignore List",
+	"gitattributes":                      "Git Attributes",
+	"gitconfig":                          "Git Config",
+	"gitignore":                          "Ignore List",
+	"gitmodules":                         "Git Config",
+	"gleam":                              "Gleam",
+	"glimmer js":                         "Glimmer JS",
+	"glimmer ts":                         "Glimmer TS",
+	"glsl":                               "GLSL",
+	"glyph":                              "Glyph",
+	"glyph bitmap distribution format":   "Glyph Bitmap Distribution Format",
+	"gn":                                 "GN",
+	"gnu asm":                            "Unix Assembly",
+	"gnuplot":                            "Gnuplot",
+	"go":                                 "Go",
+	"go checksums":                       "Go Checksums",
+	"go mod":                             "Go Module",
+	"go module":                          "Go Module",
+	"go sum":                             "Go Checksums",
+	"go work":                            "Go Workspace",
+	"go work sum":                        "Go Checksums",
+	"go workspace":                       "Go Workspace",
+	"go.mod":                             "Go Module",
+	"go.sum":                             "Go Checksums",
+	"go.work":                            "Go Workspace",
+	"go.work.sum":                        "Go Checksums",
+	"godot resource":                     "Godot Resource",
+	"golang":                             "Go",
+	"golo":                               "Golo",
+	"gosu":                               "Gosu",
+	"grace":                              "Grace",
+	"gradle":                             "Gradle",
+	"gradle kotlin dsl":                  "Gradle Kotlin DSL",
+	"grammatical framework":              "Grammatical Framework",
+	"graph modeling language":            "Graph Modeling Language",
+	"graphql":                            "GraphQL",
+	"graphviz (dot)":                     "Graphviz (DOT)",
+	"groff":                              "Roff",
+	"groovy":                             "Groovy",
+	"groovy server pages":                "Groovy Server Pages",
+	"gsc":                                "GSC",
+	"gsp":                                "Groovy Server Pages",
+	"hack":                               "Hack",
+	"haml":                               "Haml",
+	"handlebars":                         "Handlebars",
+	"haproxy":                            "HAProxy",
+	"harbour":                            "Harbour",
+	"hare":                               "Hare",
+	"hash":                               "Checksums",
+	"hashes":                             "Checksums",
+	"hashicorp configuration language":   "HCL",
+	"haskell":                            "Haskell",
+	"haxe":                               "Haxe",
+	"hbs":                                "Handlebars",
+	"hcl":                                "HCL",
+	"heex":                               "HTML+EEX",
+	"help":                               "Vim Help File",
+	"hip":                                "HIP",
+	"hiveql":                             "HiveQL",
+	"hls playlist":                       "M3U",
+	"hlsl":                               "HLSL",
+	"hocon":                              "HOCON",
+	"holyc":                              "HolyC",
+	"hoon":                               "hoon",
+	"hosts":                              "Hosts File",
+	"hosts file":                         "Hosts File",
+	"html":                               "HTML",
+	"html+django":                        "Jinja",
+	"html+ecr":                           "HTML+ECR",
+	"html+eex":                           "HTML+EEX",
+	"html+erb":                           "HTML+ERB",
+	"html+jinja":                         "Jinja",
+	"html+php":                           "HTML+PHP",
+	"html+razor":                         "HTML+Razor",
+	"html+ruby":                          "HTML+ERB",
+	"htmlbars":                           "Handlebars",
+	"htmldjango":                         "Jinja",
+	"http":                               "HTTP",
+	"hxml":                               "HXML",
+	"hy":                                 "Hy",
+	"hylang":                             "Hy",
+	"hyphy":                              "HyPhy",
+	"i7":                                 "Inform 7",
+	"ical":                               "iCalendar",
+	"icalendar":                          "iCalendar",
+	"idl":                                "IDL",
+	"idris":                              "Idris",
+	"ignore":                             "Ignore List",
+	"ignore list":                        "Ignore List",
+	"igor":                               "IGOR Pro",
+	"igor pro":                           "IGOR Pro",
+	"igorpro":                            "IGOR Pro",
+	"ijm":                                "ImageJ Macro",
+	"ile rpg":                            "RPGLE",
+	"imagej macro":                       "ImageJ Macro",
+	"imba":                               "Imba",
+	"inc":                                "PHP",
+	"inform 7":                           "Inform 7",
+	"inform7":                            "Inform 7",
+	"ini":                                "INI",
+	"ink":                                "Ink",
+	"inno setup":                         "Inno Setup",
+	"inputrc":                            "Readline Config",
+	"io":                                 "Io",
+	"ioke":                               "Ioke",
+	"ipython notebook":                   "Jupyter Notebook",
+	"irc":                                "IRC log",
+	"irc log":                            "IRC log",
+	"irc logs":                           "IRC log",
+	"isabelle":                           "Isabelle",
+	"isabelle root":                      "Isabelle ROOT",
+	"ispc":                               "ISPC",
+	"j":                                  "J",
+	"jai":                                "Jai",
+	"janet":                              "Janet",
+	"jar manifest":                       "JAR Manifest",
+	"jasmin":                             "Jasmin",
+	"java":                               "Java",
+	"java properties":                    "Java Properties",
+	"java server page":                   "Groovy Server Pages",
+	"java server pages":                  "Java Server Pages",
+	"java template engine":               "Java Template Engine",
+	"javascript":                         "JavaScript",
+	"javascript+erb":                     "JavaScript+ERB",
+	"jcl":                                "JCL",
+	"jest snapshot":                      "Jest Snapshot",
+	"jetbrains mps":                      "JetBrains MPS",
+	"jflex":                              "JFlex",
+	"jinja":                              "Jinja",
+	"jison":                              "Jison",
+	"jison lex":                          "Jison Lex",
+	"jolie":                              "Jolie",
+	"jq":                                 "jq",
+	"jruby":                              "Ruby",
+	"js":                                 "JavaScript",
+	"json":                               "JSON",
+	"json with comments":                 "JSON with Comments",
+	"json5":                              "JSON5",
+	"jsonc":                              "JSON with Comments",
+	"jsoniq":                             "JSONiq",
+	"jsonl":                              "JSON",
+	"jsonld":                             "JSONLD",
+	"jsonnet":                            "Jsonnet",
+	"jsp":                                "Java Server Pages",
+	"jte":                                "Java Template Engine",
+	"julia":                              "Julia",
+	"julia repl":                         "Julia REPL",
+	"jupyter notebook":                   "Jupyter Notebook",
+	"just":                               "Just",
+	"justfile":                           "Just",
+	"kaitai struct":                      "Kaitai Struct",
+	"kak":                                "KakouneScript",
+	"kakounescript":                      "KakouneScript",
+	"kakscript":                          "KakouneScript",
+	"kdl":                                "KDL",
+	"kerboscript":                        "KerboScript",
+	"keyvalues":                          "Valve Data Format",
+	"kicad layout":                       "KiCad Layout",
+	"kicad legacy layout":                "KiCad Legacy Layout",
+	"kicad schematic":                    "KiCad Schematic",
+	"kickstart":                          "Kickstart",
+	"kit":                                "Kit",
+	"koka":                               "Koka",
+	"kolmafia ash":                       "KoLMafia ASH",
+	"kotlin":                             "Kotlin",
+	"krl":                                "KRL",
+	"ksy":                                "Kaitai Struct",
+	"kusto":                              "Kusto",
+	"kvlang":                             "kvlang",
+	"labview":                            "LabVIEW",
+	"lark":                               "Lark",
+	"lasso":                              "Lasso",
+	"lassoscript":                        "Lasso",
+	"latex":                              "TeX",
+	"latte":                              "Latte",
+	"lean":                               "Lean",
+	"lean 4":                             "Lean 4",
+	"leex":                               "HTML+EEX",
+	"leo":                                "Leo",
+	"less":                               "Less",
+	"less-css":                           "Less",
+	"lex":                                "Lex",
+	"lfe":                                "LFE",
+	"lhaskell":                           "Literate Haskell",
+	"lhs":                                "Literate Haskell",
+	"ligolang":                           "LigoLANG",
+	"lilypond":                           "LilyPond",
+	"limbo":                              "Limbo",
+	"linear programming":                 "Linear Programming",
+	"linker script":                      "Linker Script",
+	"linux kernel module":                "Linux Kernel Module",
+	"liquid":                             "Liquid",
+	"lisp":                               "Common Lisp",
+	"litcoffee":                          "Literate CoffeeScript",
+	"literate agda":                      "Literate Agda",
+	"literate coffeescript":              "Literate CoffeeScript",
+	"literate haskell":                   "Literate Haskell",
+	"live-script":                        "LiveScript",
+	"livecode script":                    "LiveCode Script",
+	"livescript":                         "LiveScript",
+	"llvm":                               "LLVM",
+	"logos":                              "Logos",
+	"logtalk":                            "Logtalk",
+	"lolcode":                            "LOLCODE",
+	"lookml":                             "LookML",
+	"loomscript":                         "LoomScript",
+	"ls":                                 "LiveScript",
+	"lsl":                                "LSL",
+	"ltspice symbol":                     "LTspice Symbol",
+	"lua":                                "Lua",
+	"luau":                               "Luau",
+	"m":                                  "M",
+	"m2":                                 "Macaulay2",
+	"m3u":                                "M3U",
+	"m3u playlist":                       "M3U",
+	"m4":                                 "M4",
+	"m4sugar":                            "M4Sugar",
+	"m68k":                               "Motorola 68K Assembly",
+	"macaulay2":                          "Macaulay2",
+	"macruby":                            "Ruby",
+	"mail":                               "E-mail",
+	"make":                               "Makefile",
+	"makefile":                           "Makefile",
+	"mako":                               "Mako",
+	"man":                                "Roff",
+	"man page":                           "Roff",
+	"man-page":                           "Roff",
+	"manpage":                            "Roff",
+	"markdown":                           "Markdown",
+	"marko":                              "Marko",
+	"markojs":                            "Marko",
+	"mask":                               "Mask",
+	"mathematica":                        "Mathematica",
+	"matlab":                             "MATLAB",
+	"maven pom":                          "Maven POM",
+	"max":                                "Max",
+	"max/msp":                            "Max",
+	"maxmsp":                             "Max",
+	"maxscript":                          "MAXScript",
+	"mbox":                               "E-mail",
+	"mcfunction":                         "mcfunction",
+	"md":                                 "Markdown",
+	"mdoc":                               "Roff",
+	"mdsvex":                             "mdsvex",
+	"mdx":                                "MDX",
+	"mediawiki":                          "Wikitext",
+	"mercury":                            "Mercury",
+	"mermaid":                            "Mermaid",
+	"mermaid example":                    "Mermaid",
+	"meson":                              "Meson",
+	"metal":                              "Metal",
+	"mf":                                 "Makefile",
+	"microsoft developer studio project": "Microsoft Developer Studio Project",
+	"microsoft visual studio solution":   "Microsoft Visual Studio Solution",
+	"minid":                              "MiniD",
+	"miniyaml":                           "MiniYAML",
+	"minizinc":                           "MiniZinc",
+	"minizinc data":                      "MiniZinc Data",
+	"mint":                               "Mint",
+	"mirah":                              "Mirah",
+	"mirc script":                        "mIRC Script",
+	"mlir":                               "MLIR",
+	"mma":                                "Mathematica",
+	"modelica":                           "Modelica",
+	"modula-2":                           "Modula-2",
+	"modula-3":                           "Modula-3",
+	"module management system":           "Module Management System",
+	"mojo":                               "Mojo",
+	"monkey":                             "Monkey",
+	"monkey c":                           "Monkey C",
+	"moocode":                            "Moocode",
+	"moonbit":                            "MoonBit",
+	"moonscript":                         "MoonScript",
+	"motoko":                             "Motoko",
+	"motorola 68k assembly":              "Motorola 68K Assembly",
+	"move":                               "Move",
+	"mps":                                "JetBrains MPS",
+	"mql4":                               "MQL4",
+	"mql5":                               "MQL5",
+	"mtml":                               "MTML",
+	"muf":                                "MUF",
+	"mumps":                              "M",
+	"mupad":                              "mupad",
+	"muse":                               "Muse",
+	"mustache":                           "Mustache",
+	"myghty":                             "Myghty",
+	"nanorc":                             "nanorc",
+	"nargo":                              "Noir",
+	"nasal":                              "Nasal",
+	"nasl":                               "NASL",
+	"nasm":                               "Assembly",
+	"ncl":                                "NCL",
+	"ne-on":                              "NEON",
+	"nearley":                            "Nearley",
+	"nemerle":                            "Nemerle",
+	"neon":                               "NEON",
+	"neosnippet":                         "Vim Snippet",
+	"nesc":                               "nesC",
+	"netlinx":                            "NetLinx",
+	"netlinx+erb":                        "NetLinx+ERB",
+	"netlogo":                            "NetLogo",
+	"nette object notation":              "NEON",
+	"newlisp":                            "NewLisp",
+	"nextflow":                           "Nextflow",
+	"nginx":                              "Nginx",
+	"nginx configuration file":           "Nginx",
+	"nickel":                             "Nickel",
+	"nim":                                "Nim",
+	"ninja":                              "Ninja",
+	"nit":                                "Nit",
+	"nix":                                "Nix",
+	"nixos":                              "Nix",
+	"njk":                                "Nunjucks",
+	"nl":                                 "NL",
+	"nmodl":                              "NMODL",
+	"node":                               "JavaScript",
+	"noir":                               "Noir",
+	"npm config":                         "NPM Config",
+	"npmrc":                              "NPM Config",
+	"nroff":                              "Roff",
+	"nsis":                               "NSIS",
+	"nu":                                 "Nu",
+	"nu-script":                          "Nushell",
+	"numpy":                              "NumPy",
+	"nunjucks":                           "Nunjucks",
+	"nush":                               "Nu",
+	"nushell":                            "Nushell",
+	"nushell-script":                     "Nushell",
+	"nvim":                               "Vim Script",
+	"nwscript":                           "NWScript",
+	"oasv2":                              "OpenAPI Specification v2",
+	"oasv2-json":                         "OASv2-json",
+	"oasv2-yaml":                         "OASv2-yaml",
+	"oasv3":                              "OpenAPI Specification v3",
+	"oasv3-json":                         "OASv3-json",
+	"oasv3-yaml":                         "OASv3-yaml",
+	"oberon":                             "Oberon",
+	"obj-c":                              "Objective-C",
+	"obj-c++":                            "Objective-C++",
+	"obj-j":                              "Objective-J",
+	"objc":                               "Objective-C",
+	"objc++":                             "Objective-C++",
+	"objdump":                            "ObjDump",
+	"object data instance notation":      "Object Data Instance Notation",
+	"objective-c":                        "Objective-C",
+	"objective-c++":                      "Objective-C++",
+	"objective-j":                        "Objective-J",
+	"objectivec":                         "Objective-C",
+	"objectivec++":                       "Objective-C++",
+	"objectivej":                         "Objective-J",
+	"objectpascal":                       "Pascal",
+	"objectscript":                       "ObjectScript",
+	"objj":                               "Objective-J",
+	"ocaml":                              "OCaml",
+	"octave":                             "MATLAB",
+	"odin":                               "Odin",
+	"odin-lang":                          "Odin",
+	"odinlang":                           "Odin",
+	"omgrofl":                            "Omgrofl",
+	"omnet++ msg":                        "OMNeT++ MSG",
+	"omnet++ ned":                        "OMNeT++ NED",
+	"omnetpp-msg":                        "OMNeT++ MSG",
+	"omnetpp-ned":                        "OMNeT++ NED",
+	"oncrpc":                             "RPC",
+	"ooc":                                "ooc",
+	"opa":                                "Opa",
+	"opal":                               "Opal",
+	"open policy agent":                  "Open Policy Agent",
+	"openapi specification v2":           "OpenAPI Specification v2",
+	"openapi specification v3":           "OpenAPI Specification v3",
+	"opencl":                             "OpenCL",
+	"openedge":                           "OpenEdge ABL",
+	"openedge abl":                       "OpenEdge ABL",
+	"openqasm":                           "OpenQASM",
+	"openrc":                             "OpenRC runscript",
+	"openrc runscript":                   "OpenRC runscript",
+	"openscad":                           "OpenSCAD",
+	"openstep property list":             "OpenStep Property List",
+	"opentype feature file":              "OpenType Feature File",
+	"option list":                        "Option List",
+	"opts":                               "Option List",
+	"org":                                "Org",
+	"osascript":                          "AppleScript",
+	"overpassql":                         "OverpassQL",
+	"ox":                                 "Ox",
+	"oxygene":                            "Oxygene",
+	"oz":                                 "Oz",
+	"p4":                                 "P4",
+	"pact":                               "Pact",
+	"pan":                                "Pan",
+	"pandoc":                             "Markdown",
+	"papyrus":                            "Papyrus",
+	"parrot":                             "Parrot",
+	"parrot assembly":                    "Parrot Assembly",
+	"parrot internal representation":     "Parrot Internal Representation",
+	"pascal":                             "Pascal",
+	"pasm":                               "Parrot Assembly",
+	"pawn":                               "Pawn",
+	"pcbnew":                             "KiCad Layout",
+	"pddl":                               "PDDL",
+	"peg.js":                             "PEG.js",
+	"pep8":                               "Pep8",
+	"perl":                               "Perl",
+	"perl-6":                             "Raku",
+	"perl6":                              "Raku",
+	"php":                                "PHP",
+	"pic":                                "Pic",
+	"pickle":                             "Pickle",
+	"picolisp":                           "PicoLisp",
+	"piglatin":                           "PigLatin",
+	"pikchr":                             "Pic",
+	"pike":                               "Pike",
+	"pip requirements":                   "Pip Requirements",
+	"pir":                                "Parrot Internal Representation",
+	"pkl":                                "Pkl",
+	"plain text":                         "Text",
+	"plantuml":                           "PlantUML",
+	"plpgsql":                            "PLpgSQL",
+	"plsql":                              "PLSQL",
+	"pod":                                "Pod",
+	"pod 6":                              "Pod 6",
+	"pogoscript":                         "PogoScript",
+	"polar":                              "Polar",
+	"pony":                               "Pony",
+	"portugol":                           "Portugol",
+	"posh":                               "PowerShell",
+	"postcss":                            "PostCSS",
+	"postscr":                            "PostScript",
+	"postscript":                         "PostScript",
+	"pot":                                "Gettext Catalog",
+	"pov-ray":                            "POV-Ray SDL",
+	"pov-ray sdl":                        "POV-Ray SDL",
+	"povray":                             "POV-Ray SDL",
+	"powerbuilder":                       "PowerBuilder",
+	"powershell":                         "PowerShell",
+	"praat":                              "Praat",
+	"prisma":                             "Prisma",
+	"processing":                         "Processing",
+	"procfile":                           "Procfile",
+	"progress":                           "OpenEdge ABL",
+	"proguard":                           "Proguard",
+	"prolog":                             "Prolog",
+	"promela":                            "Promela",
+	"propeller spin":                     "Propeller Spin",
+	"proto":                              "Protocol Buffer",
+	"protobuf":                           "Protocol Buffer",
+	"protobuf text format":               "Protocol Buffer Text Format",
+	"protocol buffer":                    "Protocol Buffer",
+	"protocol buffer text format":        "Protocol Buffer Text Format",
+	"protocol buffers":                   "Protocol Buffer",
+	"public key":                         "Public Key",
+	"pug":                                "Pug",
+	"puppet":                             "Puppet",
+	"pure data":                          "Pure Data",
+	"purebasic":                          "PureBasic",
+	"purescript":                         "PureScript",
+	"pwsh":                               "PowerShell",
+	"pycon":                              "Python console",
+	"pyret":                              "Pyret",
+	"pyrex":                              "Cython",
+	"python":                             "Python",
+	"python console":                     "Python console",
+	"python traceback":                   "Python traceback",
+	"python3":                            "Python",
+	"q":                                  "q",
+	"q#":                                 "Q#",
+	"qb":                                 "QuickBASIC",
+	"qb64":                               "QuickBASIC",
+	"qbasic":                             "QuickBASIC",
+	"ql":                                 "CodeQL",
+	"qmake":                              "QMake",
+	"qml":                                "QML",
+	"qsharp":                             "Q#",
+	"qt script":                          "Qt Script",
+	"quake":                              "Quake",
+	"quakec":                             "QuakeC",
+	"quickbasic":                         "QuickBASIC",
+	"r":                                  "R",
+	"racket":                             "Racket",
+	"ragel":                              "Ragel",
+	"ragel-rb":                           "Ragel",
+	"ragel-ruby":                         "Ragel",
+	"rake":                               "Ruby",
+	"raku":                               "Raku",
+	"raml":                               "RAML",
+	"rascal":                             "Rascal",
+	"raw":                                "Raw token data",
+	"raw token data":                     "Raw token data",
+	"razor":                              "HTML+Razor",
+	"rb":                                 "Ruby",
+	"rbs":                                "RBS",
+	"rbx":                                "Ruby",
+	"rdoc":                               "RDoc",
+	"readline":                           "Readline Config",
+	"readline config":                    "Readline Config",
+	"realbasic":                          "REALbasic",
+	"reason":                             "Reason",
+	"reasonligo":                         "ReasonLIGO",
+	"rebol":                              "Rebol",
+	"record jar":                         "Record Jar",
+	"red":                                "Red",
+	"red/system":                         "Red",
+	"redcode":                            "Redcode",
+	"redirect rules":                     "Redirect Rules",
+	"redirects":                          "Redirect Rules",
+	"regex":                              "Regular Expression",
+	"regexp":                             "Regular Expression",
+	"regular expression":                 "Regular Expression",
+	"ren'py":                             "Ren'Py",
+	"renderscript":                       "RenderScript",
+	"renpy":                              "Ren'Py",
+	"rescript":                           "ReScript",
+	"restructuredtext":                   "reStructuredText",
+	"rexx":                               "REXX",
+	"rez":                                "Rez",
+	"rhtml":                              "HTML+ERB",
+	"rich text format":                   "Rich Text Format",
+	"ring":                               "Ring",
+	"riot":                               "Riot",
+	"rmarkdown":                          "RMarkdown",
+	"robotframework":                     "RobotFramework",
+	"robots":                             "robots.txt",
+	"robots txt":                         "robots.txt",
+	"robots.txt":                         "robots.txt",
+	"roc":                                "Roc",
+	"rocq":                               "Rocq Prover",
+	"rocq prover":                        "Rocq Prover",
+	"roff":                               "Roff",
+	"roff manpage":                       "Roff Manpage",
+	"ron":                                "RON",
+	"ros interface":                      "ROS Interface",
+	"rosmsg":                             "ROS Interface",
+	"rouge":                              "Rouge",
+	"routeros script":                    "RouterOS Script",
+	"rpc":                                "RPC",
+	"rpcgen":                             "RPC",
+	"rpgle":                              "RPGLE",
+	"rpm spec":                           "RPM Spec",
+	"rs":                                 "Rust",
+	"rs-274x":                            "Gerber Image",
+	"rscript":                            "R",
+	"rss":                                "XML",
+	"rst":                                "reStructuredText",
+	"ruby":                               "Ruby",
+	"runoff":                             "RUNOFF",
+	"rust":                               "Rust",
+	"rusthon":                            "Python",
+	"sage":                               "Sage",
+	"sail":                               "Sail",
+	"salt":                               "SaltStack",
+	"saltstack":                          "SaltStack",
+	"saltstate":                          "SaltStack",
+	"sarif":                              "JSON",
+	"sas":                                "SAS",
+	"sass":                               "Sass",
+	"scala":                              "Scala",
+	"scaml":                              "Scaml",
+	"scenic":                             "Scenic",
+	"scheme":                             "Scheme",
+	"scilab":                             "Scilab",
+	"scss":                               "SCSS",
+	"sdc":                                "Tcl",
+	"sed":                                "sed",
+	"self":                               "Self",
+	"selinux kernel policy language":     "SELinux Policy",
+	"selinux policy":                     "SELinux Policy",
+	"sepolicy":                           "SELinux Policy",
+	"sfv":                                "Simple File Verification",
+	"sh":                                 "Shell",
+	"shaderlab":                          "ShaderLab",
+	"shell":                              "Shell",
+	"shell-script":                       "Shell",
+	"shellcheck config":                  "ShellCheck Config",
+	"shellcheckrc":                       "ShellCheck Config",
+	"shellsession":                       "ShellSession",
+	"shen":                               "Shen",
+	"sieve":                              "Sieve",
+	"simple file verification":           "Simple File Verification",
+	"singularity":                        "Singularity",
+	"slang":                              "Slang",
+	"slash":                              "Slash",
+	"slice":                              "Slice",
+	"slim":                               "Slim",
+	"slint":                              "Slint",
+	"smali":                              "Smali",
+	"smalltalk":                          "Smalltalk",
+	"smarty":                             "Smarty",
+	"smithy":                             "Smithy",
+	"sml":                                "Standard ML",
+	"smpl":                               "SmPL",
+	"smt":                                "SMT",
+	"snakefile":                          "Snakemake",
+	"snakemake":                          "Snakemake",
+	"snipmate":                           "Vim Snippet",
+	"snippet":                            "YASnippet",
+	"solidity":                           "Solidity",
+	"soong":                              "Soong",
+	"sourcemod":                          "SourcePawn",
+	"sourcepawn":                         "SourcePawn",
+	"soy":                                "Closure Templates",
+	"sparql":                             "SPARQL",
+	"specfile":                           "RPM Spec",
+	"spline font database":               "Spline Font Database",
+	"splus":                              "R",
+	"sqf":                                "SQF",
+	"sql":                                "SQL",
+	"sqlpl":                              "SQLPL",
+	"sqlrpgle":                           "RPGLE",
+	"squeak":                             "Smalltalk",
+	"squirrel":                           "Squirrel",
+	"srecode template":                   "SRecode Template",
+	"ssh config":                         "SSH Config",
+	"ssh_config":                         "SSH Config",
+	"sshconfig":                          "SSH Config",
+	"sshd_config":                        "SSH Config",
+	"sshdconfig":                         "SSH Config",
+	"stan":                               "Stan",
+	"standard ml":                        "Standard ML",
+	"star":                               "STAR",
+	"starlark":                           "Starlark",
+	"stata":                              "Stata",
+	"stl":                                "STL",
+	"stla":                               "STL",
+	"ston":                               "STON",
+	"stringtemplate":                     "StringTemplate",
+	"stylus":                             "Stylus",
+	"subrip text":                        "SubRip Text",
+	"sugarss":                            "SugarSS",
+	"sum":                                "Checksums",
+	"sums":                               "Checksums",
+	"supercollider":                      "SuperCollider",
+	"survex data":                        "Survex data",
+	"svelte":                             "Svelte",
+	"svg":                                "SVG",
+	"sway":                               "Sway",
+	"sweave":                             "Sweave",
+	"swift":                              "Swift",
+	"swig":                               "SWIG",
+	"systemverilog":                      "SystemVerilog",
+	"tab-seperated values":               "TSV",
+	"tact":                               "Tact",
+	"talon":                              "Talon",
+	"tcl":                                "Tcl",
+	"tcsh":                               "Tcsh",
+	"tea":                                "Tea",
+	"templ":                              "templ",
+	"terra":                              "Terra",
+	"terraform":                          "HCL",
+	"terraform template":                 "Terraform Template",
+	"tex":                                "TeX",
+	"texinfo":                            "Texinfo",
+	"text":                               "Text",
+	"text proto":                         "Protocol Buffer Text Format",
+	"textgrid":                           "TextGrid",
+	"textile":                            "Textile",
+	"textmate properties":                "TextMate Properties",
+	"thrift":                             "Thrift",
+	"ti program":                         "TI Program",
+	"tl":                                 "Type Language",
+	"tl-verilog":                         "TL-Verilog",
+	"tla":                                "TLA",
+	"tm-properties":                      "TextMate Properties",
+	"toit":                               "Toit",
+	"toml":                               "TOML",
+	"topojson":                           "JSON",
+	"tor config":                         "Tor Config",
+	"torrc":                              "Tor Config",
+	"traveling salesman problem":         "TSPLIB data",
+	"travelling salesman problem":        "TSPLIB data",
+	"tree-sitter query":                  "Tree-sitter Query",
+	"troff":                              "Roff",
+	"ts":                                 "TypeScript",
+	"tsp":                                "TypeSpec",
+	"tsplib data":                        "TSPLIB data",
+	"tsq":                                "Tree-sitter Query",
+	"tsql":                               "TSQL",
+	"tsv":                                "TSV",
+	"tsx":                                "TSX",
+	"turing":                             "Turing",
+	"turtle":                             "Turtle",
+	"twig":                               "Twig",
+	"txl":                                "TXL",
+	"typ":                                "Typst",
+	"type language":                      "Type Language",
+	"typescript":                         "TypeScript",
+	"typespec":                           "TypeSpec",
+	"typst":                              "Typst",
+	"udiff":                              "Diff",
+	"ultisnip":                           "Vim Snippet",
+	"ultisnips":                          "Vim Snippet",
+	"unified parallel c":                 "Unified Parallel C",
+	"unity3d asset":                      "Unity3D Asset",
+	"unix asm":                           "Unix Assembly",
+	"unix assembly":                      "Unix Assembly",
+	"uno":                                "Uno",
+	"unrealscript":                       "UnrealScript",
+	"untyped plutus core":                "Untyped Plutus Core",
+	"ur":                                 "UrWeb",
+	"ur/web":                             "UrWeb",
+	"urweb":                              "UrWeb",
+	"v":                                  "V",
+	"vala":                               "Vala",
+	"valve data format":                  "Valve Data Format",
+	"vb .net":                            "Visual Basic .NET",
+	"vb 6":                               "Visual Basic 6.0",
+	"vb.net":                             "Visual Basic .NET",
+	"vb6":                                "Visual Basic 6.0",
+	"vba":                                "VBA",
+	"vbnet":                              "Visual Basic .NET",
+	"vbscript":                           "VBScript",
+	"vcard":                              "vCard",
+	"vcl":                                "VCL",
+	"vdf":                                "Valve Data Format",
+	"velocity":                           "Velocity Template Language",
+	"velocity template language":         "Velocity Template Language",
+	"vento":                              "Vento",
+	"verilog":                            "Verilog",
+	"vhdl":                               "VHDL",
+	"vim":                                "Vim Script",
+	"vim help file":                      "Vim Help File",
+	"vim script":                         "Vim Script",
+	"vim snippet":                        "Vim Snippet",
+	"vimhelp":                            "Vim Help File",
+	"viml":                               "Vim Script",
+	"vimscript":                          "Vim Script",
+	"virtual contact file":               "vCard",
+	"visual basic":                       "Visual Basic .NET",
+	"visual basic .net":                  "Visual Basic .NET",
+	"visual basic 6":                     "Visual Basic 6.0",
+	"visual basic 6.0":                   "Visual Basic 6.0",
+	"visual basic classic":               "Visual Basic 6.0",
+	"visual basic for applications":      "VBA",
+	"vlang":                              "V",
+	"volt":                               "Volt",
+	"vtl":                                "Velocity Template Language",
+	"vtt":                                "WebVTT",
+	"vue":                                "Vue",
+	"vyper":                              "Vyper",
+	"wasm":                               "WebAssembly",
+	"wast":                               "WebAssembly",
+	"wavefront material":                 "Wavefront Material",
+	"wavefront object":                   "Wavefront Object",
+	"wdl":                                "WDL",
+	"web ontology language":              "Web Ontology Language",
+	"webassembly":                        "WebAssembly",
+	"webassembly interface type":         "WebAssembly Interface Type",
+	"webidl":                             "WebIDL",
+	"webvtt":                             "WebVTT",
+	"wget config":                        "Wget Config",
+	"wgetrc":                             "Wget Config",
+	"wgsl":                               "WGSL",
+	"whiley":                             "Whiley",
+	"wiki":                               "Wikitext",
+	"wikitext":                           "Wikitext",
+	"win32 message file":                 "Win32 Message File",
+	"winbatch":                           "Batchfile",
+	"windows registry entries":           "Windows Registry Entries",
+	"wisp":                               "wisp",
+	"wit":                                "WebAssembly Interface Type",
+	"witcher script":                     "Witcher Script",
+	"wl":                                 "Mathematica",
+	"wolfram":                            "Mathematica",
+	"wolfram lang":                       "Mathematica",
+	"wolfram language":                   "Mathematica",
+	"wollok":                             "Wollok",
+	"workflow description language":      "WDL",
+	"world of warcraft addon data":       "World of Warcraft Addon Data",
+	"wren":                               "Wren",
+	"wrenlang":                           "Wren",
+	"wsdl":                               "XML",
+	"x bitmap":                           "X BitMap",
+	"x font directory index":             "X Font Directory Index",
+	"x pixmap":                           "X PixMap",
+	"x10":                                "X10",
+	"xbase":                              "xBase",
+	"xbm":                                "X BitMap",
+	"xc":                                 "XC",
+	"xcompose":                           "XCompose",
+	"xdc":                                "Tcl",
+	"xdr":                                "RPC",
+	"xhtml":                              "HTML",
+	"xmake":                              "Xmake",
+	"xml":                                "XML",
+	"xml property list":                  "XML Property List",
+	"xml+genshi":                         "Genshi",
+	"xml+kid":                            "Genshi",
+	"xojo":                               "Xojo",
+	"xonsh":                              "Xonsh",
+	"xpages":                             "XPages",
+	"xpm":                                "X PixMap",
+	"xproc":                              "XProc",
+	"xquery":                             "XQuery",
+	"xs":                                 "XS",
+	"xsd":                                "XML",
+	"xsl":                                "XSLT",
+	"xslt":                               "XSLT",
+	"xten":                               "X10",
+	"xtend":                              "Xtend",
+	"yacc":                               "Yacc",
+	"yaml":                               "YAML",
+	"yang":                               "YANG",
+	"yara":                               "YARA",
+	"yas":                                "YASnippet",
+	"yasnippet":                          "YASnippet",
+	"yml":                                "YAML",
+	"yul":                                "Yul",
+	"zap":                                "ZAP",
+	"zeek":                               "Zeek",
+	"zenscript":                          "ZenScript",
+	"zephir":                             "Zephir",
+	"zig":                                "Zig",
+	"zil":                                "ZIL",
+	"zimpl":                              "Zimpl",
+	"zmodel":                             "Zmodel",
+	"zsh":                                "Shell",
+}
@@ -1,23 +1,27 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"image"
+	"io"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/cli/go-gh/v2/pkg/browser"
+	"github.com/mattn/go-runewidth"
 )
 
 // loadingState tracks which data is currently being fetched
 type loadingState struct {
 	profile       bool
 	contributions bool
-	languages     bool
 	repositories  bool
 	activities    bool
 	avatar        bool
@@ -25,7 +29,7 @@ type loadingState struct {
 
 // isLoading returns true if any data is still loading
 func (ls loadingState) isLoading() bool {
-	return ls.profile || ls.contributions || ls.languages || ls.repositories || ls.activities || ls.avatar
+	return ls.profile || ls.contributions || ls.repositories || ls.activities || ls.avatar
 }
 
 // PushGranularity represents the time period for push stats
@@ -38,63 +42,182 @@ const (
 	PushPerMonth PushGranularity = "month"
 )
 
+// LanguageMode selects how the language breakdown is weighted
+type LanguageMode string
+
+const (
+	LanguagesByRepos LanguageMode = "repos"
+	LanguagesByBytes LanguageMode = "bytes"
+)
+
 // Model represents the application state following Elm architecture
 type Model struct {
-	username        string
-	isOwnProfile    bool // Determined once at startup - viewing authenticated user's profile
-	publicOnly      bool // Toggle with 'P' key
-	pushGranularity PushGranularity
-	client          *GitHubClient
-	profile         *ProfileData
-	contributions   []Contribution
-	languages       []LanguageStats
-	repoCount       int
-	repositories    []Repository
-	activities      []Activity
-	avatarImage     image.Image
-	graph           *Graph
-	viewport        viewport.Model
-	spinner         spinner.Model
-	loading         loadingState
-	err             error
-	ready           bool
-	width           int
-	height          int
+	username             string
+	host                 string    // github.com or a GitHub Enterprise Server hostname
+	isOwnProfile         bool      // Determined once at startup - viewing authenticated user's profile
+	isOrg                bool      // Organization profile (gittui org <name>)
+	publicOnly           bool      // Toggle with 'P' key
+	offline              bool      // --offline: render the saved snapshot, never fetch
+	staleSince           time.Time // Non-zero when showing a snapshot instead of live data
+	snapshotPending      bool      // Save a snapshot once the current load completes
+	loadSeq              int       // Identifies the current dashboard load; older results are dropped
+	loadPending          int       // Fetches of the current load still in flight
+	loadErr              error     // First failure of the current load
+	pushGranularity      PushGranularity
+	client               ProfileSource
+	rateLimits           *RateLimitTracker // Quota seen on API responses (nil offline)
+	profile              *ProfileData
+	contributions        []Contribution
+	contribYears         []int               // Years with contributions, most recent first
+	contribTotals        *ContributionTotals // Totals by kind for the displayed calendar period
+	contribMembers       int                 // Member calendars summed into an organization graph
+	contribSkipped       int                 // Member calendars that failed to load
+	activityCursor       int                 // Selected activity row
+	expandedActivities   map[string]bool     // Expanded activity rows by activityKey
+	notice               string              // One-off status message, cleared on the next key
+	filter               ActivityFilter      // Activity table filter, also scopes the metrics
+	filtering            bool                // Filter prompt open ('/' key)
+	filterInput          textinput.Model
+	filterQueryBefore    string         // Query restored when the prompt is cancelled
+	showAnalytics        bool           // Productivity analytics replace streaks ('a' key)
+	timeWindow           TimeWindow     // Window for peak hour and histogram ('w' key)
+	location             *time.Location // Zone for hour-of-day stats (--tz)
+	selectedYear         int            // Calendar year shown in the graph, 0 = last 12 months ('[' / ']')
+	calendarErr          string         // Last failed year step, shown in the graph title
+	loadingYear          bool           // A year step is loading; the rest of the dashboard stays up
+	allRepos             []Repository   // Every repository, fetched once and shared by languages and top repos
+	languages            []LanguageStats
+	languageMode         LanguageMode    // Toggle with 'L' key
+	languageBytes        []LanguageStats // Fetched lazily the first time bytes mode is shown
+	languageBytesErr     error           // Bytes mode failing to load doesn't fail the dashboard
+	loadingBytes         bool
+	repoCount            int
+	repositories         []Repository // Top repositories by stars
+	repoCursor           int          // Selected top repository
+	detailRepo           string       // Full name of the open repository detail page, "" on the dashboard
+	repoDetail           *RepoDetail
+	repoDetailErr        error
+	loadingRepoDetail    bool
+	repoBrowsing         bool       // Full repository browser open ('b' key)
+	repoFilter           RepoFilter // Repository browser filters and search
+	repoSort             RepoSort   // Repository browser order ('s' key)
+	repoSortReverse      bool       // Reverse the browser order ('S' key)
+	repoBrowserCursor    int        // Selected browser row
+	repoSearching        bool       // Browser search prompt open ('/' key)
+	repoSearchInput      textinput.Model
+	repoQueryBefore      string // Search restored when the prompt is cancelled
+	activities           []Activity
+	avatarImage          image.Image
+	graph                *Graph
+	levelScale           LevelScale                   // How counts map to graph intensity levels
+	graphFocus           bool                         // Graph cursor mode ('c' key or mouse click)
+	dayDetails           map[string]*DayContributions // Per-day breakdowns keyed by YYYY-MM-DD
+	dayDetailErr         map[string]error
+	detailSeq            int       // Debounces detail fetches while the cursor moves
+	focus                focusArea // Row column receiving cursor keys ('tab' key)
+	pullRequests         []PullRequest
+	prErr                error // Pull requests failing to load doesn't fail the dashboard
+	loadingPRs           bool
+	prCursor             int           // Selected pull request row
+	issues               []IssueSearch // Indexed by IssueQuery
+	issueErr             error
+	loadingIssues        bool
+	issueQuery           IssueQuery // Search shown in the Issues column ('v' key)
+	issueCursor          int
+	notifications        []Notification // Unread inbox grouped by repository (own profile only)
+	notificationErr      error
+	loadingNotifications bool
+	notificationCursor   int
+	notificationsSince   string // Last-Modified of the previous poll
+	notificationSeq      int    // Identifies the current poll loop; stale loops stop
+	viewport             viewport.Model
+	prViewport           viewport.Model
+	issueViewport        viewport.Model
+	notificationViewport viewport.Model
+	repoViewport         viewport.Model // Scrollable body of the repository detail page
+	spinner              spinner.Model
+	loading              loadingState
+	err                  error
+	ready                bool
+	width                int
+	height               int
 }
 
 // Messages for async data fetching
 type profileMsg *ProfileData
-type contributionsMsg []Contribution
-type languagesMsg struct {
+type contributionsMsg *ContributionCalendar
+type repositoriesMsg []Repository
+type languageBytesMsg struct {
 	languages []LanguageStats
-	repoCount int
+	err       error
 }
-type repositoriesMsg []Repository
 type activitiesMsg []Activity
+type pullRequestsMsg struct {
+	prs []PullRequest
+	err error
+}
+type issuesMsg struct {
+	issues []IssueSearch
+	err    error
+}
+type notificationsMsg struct {
+	seq  int
+	poll *NotificationPoll
+	err  error
+}
+type notificationTickMsg struct{ seq int }
+type notificationActionMsg struct {
+	id     string
+	action string // "read", "done" or "unsubscribe"
+	err    error
+}
 type avatarMsg image.Image
+type browseErrMsg struct{ err error }
+type calendarErrMsg struct {
+	year int // Year to go back to
+	err  error
+}
+type dayDetailMsg struct {
+	date   time.Time
+	detail *DayContributions
+	err    error
+}
+type detailTickMsg struct {
+	seq  int
+	date time.Time
+}
 type errMsg error
 
+// loadMsg carries the result of a fetch belonging to dashboard load seq
+type loadMsg struct {
+	seq int
+	msg tea.Msg
+}
+
+// startLoadMsg starts the initial dashboard load, which Init can't record
+// on its copy of the model
+type startLoadMsg struct{}
+
 // Init initializes the model and kicks off data fetching
 func (m Model) Init() tea.Cmd {
-	includePrivate := m.isOwnProfile && !m.publicOnly
+	if m.offline {
+		return nil
+	}
 
 	// Mark all data as loading
 	m.loading = loadingState{
 		profile:       true,
 		contributions: true,
-		languages:     true,
 		repositories:  true,
 		activities:    true,
 	}
 
-	return tea.Batch(
-		m.spinner.Tick,
-		fetchProfile(m.client, m.username, includePrivate),
-		fetchContributions(m.client, m.username),
-		fetchLanguages(m.client, m.username, includePrivate),
-		fetchRepositories(m.client, m.username, includePrivate),
-		fetchActivities(m.client, m.username, includePrivate),
-	)
+	cmds := []tea.Cmd{m.spinner.Tick, func() tea.Msg { return startLoadMsg{} }}
+	// The inbox is only readable for the authenticated user
+	if m.isOwnProfile {
+		cmds = append(cmds, fetchNotifications(m.client, m.notificationSeq, ""))
+	}
+	return tea.Batch(cmds...)
 }
 
 // Update handles messages and updates the model
@@ -109,45 +232,182 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		m.notice = ""
+
+		// The repository detail page and browser replace the dashboard and its keys
+		if m.detailRepo != "" {
+			return m.updateRepoDetail(msg)
+		}
+		if m.repoBrowsing {
+			return m.updateRepoBrowser(msg)
+		}
+
+		// The filter prompt captures all keys while open
+		if m.filtering {
+			return m.updateFilterPrompt(msg)
+		}
+
+		// Graph cursor mode captures movement keys
+		if m.graphFocus && m.graph != nil {
+			if handled, cmd := m.handleGraphKey(msg.String()); handled {
+				return m, cmd
+			}
+		}
+
 		switch msg.String() {
 		case "q", "ctrl+c":
 			return m, tea.Quit
+		case "c", "C":
+			// Focus the contribution graph cursor
+			if m.graph == nil {
+				return m, nil
+			}
+			m.graphFocus = true
+			m.graph.CursorToLatest()
+			cmd = m.scheduleDayDetail()
+			return m, cmd
 		case "r":
+			if m.offline {
+				return m, nil
+			}
 			// Refresh all data
+			m.staleSince = time.Time{}
+			m.snapshotPending = true
 			m.loading = loadingState{
 				profile:       true,
 				contributions: true,
-				languages:     true,
 				repositories:  true,
 				activities:    true,
 			}
+			m.loadingPRs = !m.isOrg
+			m.loadingIssues = !m.isOrg
 			includePrivate := m.isOwnProfile && !m.publicOnly
-			return m, tea.Batch(
-				fetchProfile(m.client, m.username, includePrivate),
-				fetchContributions(m.client, m.username),
-				fetchLanguages(m.client, m.username, includePrivate),
-				fetchRepositories(m.client, m.username, includePrivate),
-				fetchActivities(m.client, m.username, includePrivate),
+			cmd = tea.Batch(
+				m.startLoad(m.fetchDashboard(includePrivate)...),
+				m.restartNotificationPoll(),
+				m.refetchLanguageBytes(),
 			)
+			return m, cmd
 		case "p", "P":
 			// Toggle public/private view (only affects own profile)
-			if !m.isOwnProfile {
+			if !m.isOwnProfile || m.offline {
 				return m, nil
 			}
 			m.publicOnly = !m.publicOnly
+			m.staleSince = time.Time{}
+			m.snapshotPending = true
 			m.loading = loadingState{
 				profile:      true,
-				languages:    true,
 				repositories: true,
 				activities:   true,
 			}
 			includePrivate := !m.publicOnly
-			return m, tea.Batch(
+			bytesCmd := m.refetchLanguageBytes()
+			loadCmd := m.startLoad(
 				fetchProfile(m.client, m.username, includePrivate),
-				fetchLanguages(m.client, m.username, includePrivate),
 				fetchRepositories(m.client, m.username, includePrivate),
 				fetchActivities(m.client, m.username, includePrivate),
 			)
+			return m, tea.Batch(loadCmd, bytesCmd)
+		case "tab":
+			// Move cursor keys to the next visible row column
+			m.cycleFocus()
+			return m, nil
+		case "up", "k", "down", "j":
+			delta := 1
+			if msg.String() == "up" || msg.String() == "k" {
+				delta = -1
+			}
+			switch m.focus {
+			case focusPullRequests:
+				m.movePRCursor(delta)
+			case focusIssues:
+				m.moveIssueCursor(delta)
+			case focusNotifications:
+				m.moveNotificationCursor(delta)
+			case focusTopRepos:
+				m.moveRepoCursor(delta)
+			default:
+				m.moveActivityCursor(delta)
+			}
+			return m, nil
+		case "v", "V":
+			// Switch the Issues column between assigned, authored and mentioned
+			m.cycleIssueQuery()
+			return m, nil
+		case "m", "d", "u":
+			// Mark the selected notification read or done, or unsubscribe from it
+			if m.focus != focusNotifications {
+				return m, nil
+			}
+			cmd = m.actOnNotification(msg.String())
+			return m, cmd
+		case "enter":
+			if m.focus == focusTopRepos {
+				// Drill into the selected repository
+				if repo, ok := m.selectedTopRepo(); ok {
+					cmd = m.openRepoDetail(repo.FullName)
+				}
+				return m, cmd
+			}
+			if m.focus != focusActivity {
+				// Pull request, issue and notification rows have no details; open them instead
+				return m, m.openFocusedRow()
+			}
+			// Expand or collapse the selected activity row
+			if activity, ok := m.selectedActivity(); ok {
+				if m.expandedActivities == nil {
+					m.expandedActivities = make(map[string]bool)
+				}
+				key := activityKey(m.activityCursor, activity)
+				m.expandedActivities[key] = !m.expandedActivities[key]
+				m.refreshActivityViewport()
+			}
+			return m, nil
+		case "o", "O":
+			// Open the selected row of the focused column in the browser
+			return m, m.openFocusedRow()
+		case "/":
+			// Open the activity filter prompt
+			m.filtering = true
+			m.filterQueryBefore = m.filter.Query
+			m.filterInput.SetValue(m.filter.Query)
+			m.filterInput.CursorEnd()
+			return m, m.filterInput.Focus()
+		case "1", "2", "3", "4", "5":
+			// Toggle an event category
+			m.filter.Toggle(toggleableCategories[msg.String()[0]-'1'])
+			m.applyActivityFilter()
+			return m, nil
+		case "s", "S":
+			// Restrict to the selected row's repository, or clear the restriction
+			if m.filter.Repo != "" {
+				m.filter.Repo = ""
+			} else if activity, ok := m.selectedActivity(); ok {
+				m.filter.Repo = activity.Repo
+			}
+			m.applyActivityFilter()
+			return m, nil
+		case "esc":
+			// Clear all activity filters
+			if m.filter.Active() {
+				m.filter = ActivityFilter{}
+				m.applyActivityFilter()
+			}
+			return m, nil
+		case "b", "B":
+			// Open the repository browser
+			m.repoBrowsing = true
+			m.repoBrowserCursor = 0
+			return m, nil
+		case "a", "A":
+			// Toggle streaks / productivity analytics
+			m.showAnalytics = !m.showAnalytics
+			return m, nil
+		case "w", "W":
+			// Cycle peak hour window
+			m.timeWindow = m.timeWindow.Next()
+			return m, nil
 		case "g", "G":
 			// Cycle through push granularity (hour -> day -> week -> month -> hour)
 			switch m.pushGranularity {
@@ -163,6 +423,32 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.pushGranularity = PushPerDay
 			}
 			return m, nil
+		case "[", "]":
+			// Step through contribution years ('[' older, ']' newer)
+			year, ok := m.stepYear(msg.String() == "[")
+			if !ok || m.offline || m.loadingYear {
+				return m, nil
+			}
+			previous := m.selectedYear
+			m.selectedYear = year
+			m.loadingYear = true
+			if m.graph != nil {
+				m.graph.SetTitle(m.graphTitle())
+			}
+			return m, yearStep(m.fetchCalendar(), previous)
+		case "l", "L":
+			// Toggle language breakdown between repo count and bytes of code
+			if m.languageMode == LanguagesByBytes {
+				m.languageMode = LanguagesByRepos
+				return m, nil
+			}
+			m.languageMode = LanguagesByBytes
+			if m.languageBytes == nil && !m.loadingBytes && !m.offline {
+				m.loadingBytes = true
+				m.languageBytesErr = nil
+				return m, fetchLanguageBytes(m.client, m.username, m.isOwnProfile && !m.publicOnly)
+			}
+			return m, nil
 		case "t", "T":
 			// Cycle through themes
 			NextTheme()
@@ -170,6 +456,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.viewport.Style = lipgloss.NewStyle().
 				Foreground(lipgloss.Color(CurrentTheme.Foreground))
 			m.viewport.SetContent(m.renderActivityList())
+			m.refreshPRViewport()
+			m.refreshIssueViewport()
+			m.refreshNotificationViewport()
 			return m, nil
 		}
 
@@ -177,61 +466,252 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.width = msg.Width
 		m.height = msg.Height
 
+		// The focused column may no longer fit
+		if !m.columnVisible(m.focus) {
+			m.focus = focusActivity
+		}
+
 		if !m.ready {
-			m.viewport = viewport.New(m.width, m.calculateActivityViewportHeight())
+			m.viewport = viewport.New(m.tableViewportWidth(), m.calculateActivityViewportHeight())
 			m.viewport.Style = lipgloss.NewStyle().
 				Foreground(lipgloss.Color(CurrentTheme.Foreground))
+			// Activities may already be present (e.g. rendered from an offline snapshot)
+			m.viewport.SetContent(m.renderActivityList())
+			m.prViewport = viewport.New(m.tableViewportWidth(), m.calculateActivityViewportHeight())
+			m.issueViewport = viewport.New(m.tableViewportWidth(), m.calculateActivityViewportHeight())
+			m.notificationViewport = viewport.New(m.tableViewportWidth(), m.calculateActivityViewportHeight())
+			m.repoViewport = viewport.New(m.width, m.height)
 			m.ready = true
+			m.refreshPRViewport()
+			m.refreshIssueViewport()
+			m.refreshNotificationViewport()
 		} else {
-			m.viewport.Width = m.width
+			m.viewport.Width = m.tableViewportWidth()
 			m.viewport.Height = m.calculateActivityViewportHeight()
+			m.prViewport.Width = m.viewport.Width
+			m.prViewport.Height = m.viewport.Height
+			m.issueViewport.Width = m.viewport.Width
+			m.issueViewport.Height = m.viewport.Height
+			m.notificationViewport.Width = m.viewport.Width
+			m.notificationViewport.Height = m.viewport.Height
+			// Column layout depends on the width
+			m.viewport.SetContent(m.renderActivityList())
+			m.refreshPRViewport()
+			m.refreshIssueViewport()
+			m.refreshNotificationViewport()
+		}
+		m.refreshRepoViewport()
+
+	case tea.MouseMsg:
+		// Clicking a graph cell focuses the graph cursor on it
+		if msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft && m.graph != nil && m.detailRepo == "" && !m.repoBrowsing {
+			x, y := m.graphOrigin()
+			if day, week, ok := m.graph.CellAt(msg.X-x, msg.Y-y); ok {
+				m.graphFocus = true
+				m.graph.SetCursor(day, week)
+				cmd = m.scheduleDayDetail()
+				return m, cmd
+			}
+		}
+
+	case detailTickMsg:
+		// Only fetch once the cursor has settled on a day
+		if msg.seq != m.detailSeq || m.offline {
+			return m, nil
+		}
+		return m, fetchDayDetail(m.client, m.username, msg.date)
+
+	case dayDetailMsg:
+		key := msg.date.Format("2006-01-02")
+		if m.dayDetails == nil {
+			m.dayDetails = make(map[string]*DayContributions)
+			m.dayDetailErr = make(map[string]error)
 		}
+		if msg.err != nil {
+			m.dayDetailErr[key] = msg.err
+		} else {
+			m.dayDetails[key] = msg.detail
+		}
+		return m, nil
 
 	case profileMsg:
 		m.profile = msg
 		m.loading.profile = false
+		// An organization's calendar sums the members listed on its profile
+		if m.isOrg && m.loading.contributions && msg != nil {
+			cmds = append(cmds, m.addToLoad(m.fetchCalendar()))
+		}
 		// Fetch avatar braille art after profile is loaded
 		if msg != nil && msg.AvatarURL != "" {
-			return m, fetchAvatar(msg.AvatarURL)
+			m.loading.avatar = true
+			if m.offline {
+				return m, nil
+			}
+			cmds = append(cmds, m.addToLoad(fetchAvatar(m.client, msg.AvatarURL)))
+			return m, tea.Batch(cmds...)
 		}
 
 	case contributionsMsg:
-		m.contributions = msg
-		m.graph = NewGraph(msg)
+		m.loadingYear = false
+		m.contributions = msg.Days
+		if len(msg.Years) > 0 {
+			m.contribYears = msg.Years
+		}
+		m.contribTotals = msg.Totals
+		m.contribMembers = msg.Members
+		m.contribSkipped = msg.Skipped
+		m.calendarErr = ""
+		m.rebuildGraph(msg.Days)
+		if m.graphFocus {
+			m.graph.CursorToLatest()
+		}
 		m.loading.contributions = false
 
-	case languagesMsg:
-		m.languages = msg.languages
-		m.repoCount = msg.repoCount
-		m.loading.languages = false
+	case calendarErrMsg:
+		// Keep showing the previous year's graph
+		m.calendarErr = fmt.Sprintf("couldn't load %s: %v", yearLabel(m.selectedYear), msg.err)
+		m.selectedYear = msg.year
+		m.loadingYear = false
+		if m.graph != nil {
+			m.graph.SetTitle(m.graphTitle())
+		}
 
 	case repositoriesMsg:
-		m.repositories = msg
+		m.setRepositories(msg)
 		m.loading.repositories = false
 
+	case languageBytesMsg:
+		m.languageBytes = msg.languages
+		m.languageBytesErr = msg.err
+		m.loadingBytes = false
+
 	case activitiesMsg:
 		m.activities = msg
+		m.activityCursor = 0
 		m.viewport.SetContent(m.renderActivityList())
 		m.viewport.GotoTop()
 		m.loading.activities = false
 
+	case pullRequestsMsg:
+		m.pullRequests = msg.prs
+		m.prErr = msg.err
+		m.loadingPRs = false
+		m.prCursor = 0
+		m.refreshPRViewport()
+		m.prViewport.GotoTop()
+
+	case issuesMsg:
+		m.issues = msg.issues
+		m.issueErr = msg.err
+		m.loadingIssues = false
+		m.issueCursor = 0
+		m.refreshIssueViewport()
+		m.issueViewport.GotoTop()
+
+	case notificationsMsg:
+		// Results of a superseded poll loop are dropped, ending that loop
+		if msg.seq != m.notificationSeq {
+			return m, nil
+		}
+		m.loadingNotifications = false
+		interval := defaultNotificationPollInterval
+		m.notificationErr = msg.err
+		if msg.err == nil {
+			if !msg.poll.NotModified {
+				m.setNotifications(msg.poll.Notifications)
+			}
+			m.notificationsSince = msg.poll.LastModified
+			interval = msg.poll.PollInterval
+		}
+		seq := m.notificationSeq
+		return m, tea.Tick(interval, func(time.Time) tea.Msg { return notificationTickMsg{seq: seq} })
+
+	case notificationTickMsg:
+		if msg.seq != m.notificationSeq || m.offline {
+			return m, nil
+		}
+		return m, fetchNotifications(m.client, m.notificationSeq, m.notificationsSince)
+
+	case notificationActionMsg:
+		if msg.err != nil {
+			m.notice = fmt.Sprintf("Could not %s notification: %v", notificationActionVerb(msg.action), msg.err)
+		}
+		return m, nil
+
+	case repoDetailMsg:
+		// Drop pages the user already left
+		if msg.name != m.detailRepo {
+			return m, nil
+		}
+		m.repoDetail = msg.detail
+		m.repoDetailErr = msg.err
+		m.loadingRepoDetail = false
+		m.refreshRepoViewport()
+		return m, nil
+
+	case browseErrMsg:
+		m.notice = fmt.Sprintf("Could not open browser: %v", msg.err)
+		return m, nil
+
 	case avatarMsg:
 		m.avatarImage = msg
 		m.loading.avatar = false
 
-	case errMsg:
-		m.err = msg
-		// Clear all loading flags on error
-		m.loading = loadingState{}
-		return m, nil
+	case startLoadMsg:
+		cmd = m.startLoad(m.fetchDashboard(m.isOwnProfile && !m.publicOnly)...)
+		return m, cmd
+
+	case loadMsg:
+		// Results of a superseded load are dropped
+		if msg.seq != m.loadSeq {
+			return m, nil
+		}
+		m.loadPending--
+		if err, ok := msg.msg.(errMsg); ok {
+			if m.loadErr == nil {
+				m.loadErr = err
+			}
+		} else {
+			updated, cmd := m.Update(msg.msg)
+			m = updated.(Model)
+			cmds = append(cmds, cmd)
+		}
+		// A failed load only gives up once its other fetches have returned
+		if m.loadPending == 0 && m.loadErr != nil {
+			m.failLoad()
+		}
+		return m, tea.Batch(cmds...)
 
 	case spinner.TickMsg:
 		m.spinner, cmd = m.spinner.Update(msg)
+		if m.loadingRepoDetail {
+			m.refreshRepoViewport()
+		}
 		return m, cmd
 	}
 
-	// Update viewport
-	m.viewport, cmd = m.viewport.Update(msg)
+	// Persist a snapshot once every fetch of a live load has succeeded
+	if m.snapshotPending && !m.loading.isLoading() && m.loadPending == 0 && m.loadErr == nil &&
+		m.err == nil && m.staleSince.IsZero() {
+		m.snapshotPending = false
+		cmds = append(cmds, saveSnapshot(snapshotFromModel(m)))
+	}
+
+	// Update the focused column's viewport
+	switch {
+	case m.detailRepo != "":
+		m.repoViewport, cmd = m.repoViewport.Update(msg)
+	case m.repoBrowsing:
+		// The browser pages with keys only
+	case m.focus == focusPullRequests:
+		m.prViewport, cmd = m.prViewport.Update(msg)
+	case m.focus == focusIssues:
+		m.issueViewport, cmd = m.issueViewport.Update(msg)
+	case m.focus == focusNotifications:
+		m.notificationViewport, cmd = m.notificationViewport.Update(msg)
+	default:
+		m.viewport, cmd = m.viewport.Update(msg)
+	}
 	cmds = append(cmds, cmd)
 
 	return m, tea.Batch(cmds...)
@@ -247,6 +727,13 @@ func (m Model) View() string {
 		return m.renderLoading()
 	}
 
+	if m.detailRepo != "" {
+		return m.renderRepoDetail()
+	}
+	if m.repoBrowsing {
+		return m.renderRepoBrowser()
+	}
+
 	// Calculate available space
 	// Reserve 1 line for status bar, and lines for spacing between sections
 	availableHeight := m.height - 1
@@ -261,6 +748,7 @@ func (m Model) View() string {
 	var sections []string
 
 	// Braille logo at top (right aligned with margin, contrasting background)
+	// Must stay logoHeight lines tall so graph mouse hit-testing lines up
 	logo := ` ⢀⡀ ⠄ ⣰⡀ ⣰⡀ ⡀⢀ ⠄
  ⣑⡺ ⠇ ⠘⠤ ⠘⠤ ⠣⠼ ⠇`
 	styledLogo := lipgloss.NewStyle().
@@ -286,9 +774,8 @@ func (m Model) View() string {
 	// Stats row (languages + streaks side by side) with padding
 	// Constrain to graph width for consistency (graph max is 108 chars)
 	statsWidth := availableWidth
-	const maxGraphWidth = 4 + (52 * 2) // dayLabelWidth + (weeksToDisplay * cellWidth) from contrib_graph.go
-	if statsWidth > maxGraphWidth {
-		statsWidth = maxGraphWidth
+	if statsWidth > minTerminalWidth { // dayLabelWidth + (weeksToDisplay * cellWidth) from contrib_graph.go
+		statsWidth = minTerminalWidth
 	}
 	statsRow := m.renderStatsRow(statsWidth)
 	if statsRow != "" {
@@ -327,6 +814,18 @@ func (m Model) View() string {
 	return lipgloss.JoinVertical(lipgloss.Left, sections...)
 }
 
+// logoHeight is the height of the braille logo: 1 line of padding + 2 lines of art
+const logoHeight = 3
+
+// graphOrigin returns the screen position of the graph's top-left corner
+func (m Model) graphOrigin() (x, y int) {
+	hMargin := 1
+	if m.width > 100 {
+		hMargin = 2
+	}
+	return hMargin, logoHeight
+}
+
 // renderLoading shows loading state with details
 func (m Model) renderLoading() string {
 	var loading []string
@@ -337,15 +836,15 @@ func (m Model) renderLoading() string {
 	if m.loading.contributions {
 		loading = append(loading, "contributions")
 	}
-	if m.loading.languages {
-		loading = append(loading, "languages")
-	}
 	if m.loading.repositories {
 		loading = append(loading, "repositories")
 	}
 	if m.loading.activities {
 		loading = append(loading, "activity")
 	}
+	if m.loading.avatar {
+		loading = append(loading, "avatar")
+	}
 
 	status := "Initializing..."
 	if len(loading) > 0 {
@@ -532,7 +1031,14 @@ func (m Model) renderProfileInfo() string {
 		}
 		info = append(info, labelStyle.Render("Co: ")+baseStyle.Render(comp))
 	}
-	if m.profile.Location != "" || m.profile.Company != "" {
+	if m.profile.Blog != "" {
+		blog := m.profile.Blog
+		if len(blog) > 20 {
+			blog = blog[:17] + "..."
+		}
+		info = append(info, labelStyle.Render("Web: ")+baseStyle.Render(blog))
+	}
+	if m.profile.Location != "" || m.profile.Company != "" || m.profile.Blog != "" {
 		info = append(info, "")
 	}
 
@@ -541,6 +1047,18 @@ func (m Model) renderProfileInfo() string {
 	if repoCount == 0 {
 		repoCount = m.profile.PublicRepos
 	}
+	if m.profile.IsOrg {
+		info = append(info, fmt.Sprintf("%s %d | %s %d",
+			labelStyle.Render("Repos:"), repoCount,
+			labelStyle.Render("Members:"), len(m.profile.Members)))
+		info = append(info, fmt.Sprintf("%s %d",
+			labelStyle.Render("Followers:"), m.profile.Followers))
+		info = append(info, "")
+		info = append(info, labelStyle.Render("Created: ")+
+			baseStyle.Render(m.profile.CreatedAt.Format("Jan 2006")))
+		return lipgloss.JoinVertical(lipgloss.Left, info...)
+	}
+
 	info = append(info, fmt.Sprintf("%s %d | %s %d",
 		labelStyle.Render("Repos:"), repoCount,
 		labelStyle.Render("Gists:"), m.profile.PublicGists))
@@ -572,12 +1090,63 @@ func (m Model) renderGraphSection(width int) string {
 	}
 
 	graph := m.graph.Render()
+	if m.graphFocus {
+		graph = lipgloss.JoinVertical(lipgloss.Left, graph, m.renderDayDetail())
+	}
 	return lipgloss.NewStyle().
 		PaddingLeft(hMargin).
 		PaddingRight(hMargin).
 		Render(graph)
 }
 
+// renderDayDetail renders the popover for the day under the graph cursor
+func (m Model) renderDayDetail() string {
+	day, week, ok := m.graph.Cursor()
+	if !ok {
+		return ""
+	}
+	contrib, ok := m.graph.ContributionAt(day, week)
+	if !ok {
+		return ""
+	}
+
+	key := contrib.Date.Format("2006-01-02")
+	header := titleStyle.Render(contrib.Date.Format("Mon, Jan 2 2006")) + "  " +
+		accentStyle.Render(fmt.Sprintf("%d contributions", contrib.Count))
+
+	lines := []string{header}
+	if m.isOrg {
+		lines = append(lines, dimStyle.Render(m.memberSummary()))
+	} else if detail, ok := m.dayDetails[key]; ok {
+		lines = append(lines, fmt.Sprintf("%s %d  %s %d  %s %d  %s %d",
+			labelStyle.Render("Commits"), detail.Commits,
+			labelStyle.Render("PRs"), detail.PRs,
+			labelStyle.Render("Issues"), detail.Issues,
+			labelStyle.Render("Reviews"), detail.Reviews))
+
+		var repos []string
+		for _, repo := range detail.Repos {
+			repos = append(repos, fmt.Sprintf("%s (%d)", repo.Repo, repo.Commits))
+		}
+		if len(repos) > 0 {
+			lines = append(lines, dimStyle.Render(strings.Join(repos, ", ")))
+		}
+	} else if err, ok := m.dayDetailErr[key]; ok {
+		lines = append(lines, errorStyle.Render(fmt.Sprintf("Breakdown unavailable: %v", err)))
+	} else if m.offline {
+		lines = append(lines, dimStyle.Render("Breakdown unavailable offline"))
+	} else {
+		lines = append(lines, labelStyle.Render(m.spinner.View()+" Loading breakdown..."))
+	}
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(CurrentTheme.Blue)).
+		Padding(0, 1).
+		MarginLeft(dayLabelWidth).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
 // renderStatsRow renders languages, streaks, activity metrics, and top repos in 4 columns
 func (m Model) renderStatsRow(width int) string {
 	// Calculate horizontal padding based on available space
@@ -597,6 +1166,9 @@ func (m Model) renderStatsRow(width int) string {
 		Render(languagesContent)
 
 	streaksContent := m.renderStreaks(colWidth)
+	if m.showAnalytics {
+		streaksContent = m.renderAnalytics(colWidth)
+	}
 	streaks := lipgloss.NewStyle().
 		PaddingRight(hPadding).
 		Render(streaksContent)
@@ -617,9 +1189,28 @@ func (m Model) renderLanguages(width int) string {
 	hMargin := 1
 	contentWidth := width - (hMargin * 2)
 
-	title := titleStyle.Render("Top Languages")
+	languages := m.languages
+	title := titleStyle.Render("Top Languages") + dimStyle.Render(" (repos)")
+	if m.languageMode == LanguagesByBytes {
+		languages = m.languageBytes
+		title = titleStyle.Render("Top Languages") + dimStyle.Render(" (bytes)")
+	}
+
+	if m.loadingBytes && m.languageMode == LanguagesByBytes {
+		content := lipgloss.JoinVertical(lipgloss.Left, title, "", labelStyle.Render(m.spinner.View()+" Loading..."))
+		return lipgloss.NewStyle().
+			PaddingLeft(hMargin).
+			Render(content)
+	}
+
+	if m.languageBytesErr != nil && m.languageMode == LanguagesByBytes {
+		content := lipgloss.JoinVertical(lipgloss.Left, title, "", errorStyle.Render(wrapLine(m.languageBytesErr.Error(), contentWidth)))
+		return lipgloss.NewStyle().
+			PaddingLeft(hMargin).
+			Render(content)
+	}
 
-	if len(m.languages) == 0 {
+	if len(languages) == 0 {
 		content := lipgloss.JoinVertical(lipgloss.Left, title, "", labelStyle.Render("No language data"))
 		return lipgloss.NewStyle().
 			PaddingLeft(hMargin).
@@ -636,7 +1227,7 @@ func (m Model) renderLanguages(width int) string {
 	}
 
 	// Limit to top 3 languages for consistency
-	displayLangs := m.languages
+	displayLangs := languages
 	if len(displayLangs) > 3 {
 		displayLangs = displayLangs[:3]
 	}
@@ -646,7 +1237,7 @@ func (m Model) renderLanguages(width int) string {
 		labelLine := baseStyle.Render(label)
 
 		// barStyle sets the width, just render a space to fill it
-		bar := barStyle(lang.Percentage, maxBarWidth, lang.Color).Render(" ")
+		bar := barStyle(lang.Percentage, maxBarWidth, CurrentTheme.LanguageColor(lang.Name, lang.Color)).Render(" ")
 
 		bars = append(bars, labelLine)
 		bars = append(bars, bar)
@@ -665,6 +1256,9 @@ func (m Model) renderStreaks(width int) string {
 	hMargin := 1
 
 	title := titleStyle.Render("Contribution Stats")
+	if m.selectedYear != 0 {
+		title += dimStyle.Render(fmt.Sprintf(" (%d)", m.selectedYear))
+	}
 
 	if len(m.contributions) == 0 {
 		content := lipgloss.JoinVertical(lipgloss.Left, title, "", labelStyle.Render("No contribution data"))
@@ -704,7 +1298,7 @@ func (m Model) renderTopRepos(width int) string {
 	// Calculate internal padding
 	hMargin := 1
 
-	title := titleStyle.Render("Top Repositories")
+	title := m.columnTitle(focusTopRepos, "Top Repositories")
 
 	if len(m.repositories) == 0 {
 		content := lipgloss.JoinVertical(lipgloss.Left, title, "", labelStyle.Render("No repositories"))
@@ -716,19 +1310,20 @@ func (m Model) renderTopRepos(width int) string {
 	var lines []string
 	lines = append(lines, title, "")
 
-	// Limit to top 3 repos for consistency
-	displayRepos := m.repositories
-	if len(displayRepos) > 3 {
-		displayRepos = displayRepos[:3]
-	}
-
-	for _, repo := range displayRepos {
-		// Repo name (grey like stat labels)
+	for i, repo := range m.displayedTopRepos() {
+		// Repo name (grey like stat labels, highlighted under the cursor)
 		repoName := repo.Name
 		if len(repoName) > width-hMargin-5 {
 			repoName = repoName[:width-hMargin-8] + "..."
 		}
-		lines = append(lines, labelStyle.Render(repoName))
+		if m.focus == focusTopRepos && i == m.repoCursor {
+			lines = append(lines, labelStyle.
+				Foreground(lipgloss.Color(CurrentTheme.Foreground)).
+				Background(lipgloss.Color(CurrentTheme.Subtle)).
+				Render(repoName))
+		} else {
+			lines = append(lines, labelStyle.Render(repoName))
+		}
 
 		// Stars and language (green accent like stat values)
 		stats := fmt.Sprintf("⭐ %d", repo.Stars)
@@ -754,7 +1349,8 @@ func (m Model) renderActivityMetrics(width int) string {
 
 	title := titleStyle.Render("Activity Metrics")
 
-	if len(m.activities) == 0 {
+	activities := m.visibleActivities()
+	if len(activities) == 0 {
 		content := lipgloss.JoinVertical(lipgloss.Left, title, "", labelStyle.Render("No activity data"))
 		return lipgloss.NewStyle().
 			PaddingLeft(hMargin).
@@ -762,36 +1358,70 @@ func (m Model) renderActivityMetrics(width int) string {
 	}
 
 	// Calculate stats
-	pushRate := CalculatePushStats(m.activities, m.pushGranularity)
-	peakHour, _ := CalculatePeakCodingHour(m.activities, ThisWeek)
+	stats := CalculateActivityStats(activities, m.pushGranularity, m.timeWindow, m.location)
+	// Account-wide totals can't be filtered, so a filter keeps the event-based rates
+	if m.contribTotals != nil && !m.filter.Active() {
+		stats.ApplyContributionTotals(m.contribTotals, m.pushGranularity)
+	}
 
 	var lines []string
-	lines = append(lines, title, "")
+	lines = append(lines, title)
+
+	// How far back the events API data reaches (at most 300 events / 90 days)
+	oldest, coverageDays := ActivityCoverage(m.activities)
+	count := fmt.Sprintf("%d events", len(m.activities))
+	if m.filter.Active() {
+		count = fmt.Sprintf("%d of %d events (filtered)", len(activities), len(m.activities))
+	}
+	lines = append(lines, dimStyle.Render(fmt.Sprintf("%s since %s (%dd)", count, oldest.In(m.location).Format("Jan 2"), coverageDays)))
+	lines = append(lines, "")
 
 	// Push rate with granularity label and calculation hint
-	var granularityLabel, hint string
+	var unit, hint string
 	switch m.pushGranularity {
 	case PushPerHour:
-		granularityLabel = "Pushes/Hour"
+		unit = "Hour"
 		hint = "(7-day avg ÷ 24)"
 	case PushPerDay:
-		granularityLabel = "Pushes/Day"
+		unit = "Day"
 		hint = "(7-day avg)"
 	case PushPerWeek:
-		granularityLabel = "Pushes/Week"
+		unit = "Week"
 		hint = "(7-day avg × 7)"
 	case PushPerMonth:
-		granularityLabel = "Pushes/Month"
+		unit = "Month"
 		hint = "(7-day avg × 30)"
 	}
-	lines = append(lines, labelStyle.Render(granularityLabel))
-	lines = append(lines, accentStyle.Render(fmt.Sprintf("%.2f", pushRate)))
+	lines = append(lines, labelStyle.Render("Pushes/"+unit))
+	lines = append(lines, accentStyle.Render(fmt.Sprintf("%.2f", stats.PushRate)))
 	lines = append(lines, dimStyle.Render(hint))
 	lines = append(lines, "")
 
-	// Peak coding hour (always this week)
-	lines = append(lines, labelStyle.Render("Peak Hour (This Week)"))
-	lines = append(lines, accentStyle.Render(peakHour))
+	// PR, review and issue rates share the push granularity
+	rates := []struct {
+		label string
+		rate  float64
+	}{
+		{"PRs/" + unit, stats.PRRate},
+		{"Reviews/" + unit, stats.ReviewRate},
+		{"Issues/" + unit, stats.IssueRate},
+	}
+	for _, r := range rates {
+		lines = append(lines, labelStyle.Render(fmt.Sprintf("%-14s", r.label))+accentStyle.Render(fmt.Sprintf("%.2f", r.rate)))
+	}
+	lines = append(lines, dimStyle.Render(stats.RateSource.Hint()))
+	lines = append(lines, "")
+
+	// Peak coding hour and hour-of-day histogram for the selected window
+	zone := time.Now().In(m.location).Format("MST")
+	peakLabel := labelStyle.Render(fmt.Sprintf("Peak Hour (%s)", m.timeWindow))
+	if coverageDays < m.timeWindow.Days() {
+		peakLabel += dimStyle.Render(fmt.Sprintf(" %dd of data", coverageDays))
+	}
+	lines = append(lines, peakLabel)
+	lines = append(lines, accentStyle.Render(stats.PeakCodingHour)+dimStyle.Render(" "+zone))
+	lines = append(lines, renderHourHistogram(stats.HourDistribution))
+	lines = append(lines, dimStyle.Render("0     6     12    18   23"))
 	lines = append(lines, "")
 
 	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
@@ -800,44 +1430,213 @@ func (m Model) renderActivityMetrics(width int) string {
 		Render(content)
 }
 
-// renderColumnsRow renders a multi-column layout (DRY and scalable)
-// Add new columns by adding to the columns slice
-func (m Model) renderColumnsRow(width, height int) string {
-	// Define columns to render (easily add more here)
-	type column struct {
-		render func(int, int) string
-		name   string
+// renderHourHistogram renders a 24-column sparkline of activity per hour of day
+func renderHourHistogram(distribution map[int]int) string {
+	maxCount := 0
+	for _, count := range distribution {
+		if count > maxCount {
+			maxCount = count
+		}
 	}
 
-	columns := []column{
-		{render: m.renderActivity, name: "activity"},
-		// Future: add more columns here
-		// {render: m.renderIssues, name: "issues"},
-		// {render: m.renderNotifications, name: "notifications"},
+	var bars strings.Builder
+	for hour := 0; hour < 24; hour++ {
+		bar := string(sparkLevel(distribution[hour], maxCount))
+		if distribution[hour] == 0 {
+			bars.WriteString(dimStyle.Render(bar))
+			continue
+		}
+		bars.WriteString(accentStyle.Render(bar))
 	}
+	return bars.String()
+}
 
-	numCols := len(columns)
-	if numCols == 0 {
-		return ""
+// renderAnalytics renders weekday, weekly trend and consistency analytics
+func (m Model) renderAnalytics(width int) string {
+	hMargin := 1
+	contentWidth := width - hMargin
+
+	title := titleStyle.Render("Productivity")
+	if m.selectedYear != 0 {
+		title += dimStyle.Render(fmt.Sprintf(" (%d)", m.selectedYear))
 	}
 
-	// Calculate responsive horizontal padding between columns
-	hPadding := 2
-	if width < 80 {
-		hPadding = 1
-	} else if width > 150 {
-		hPadding = 3
+	if len(m.contributions) == 0 {
+		content := lipgloss.JoinVertical(lipgloss.Left, title, "", labelStyle.Render("No contribution data"))
+		return lipgloss.NewStyle().
+			PaddingLeft(hMargin).
+			Render(content)
 	}
 
-	// Calculate column width: (total width - total padding) / number of columns
-	totalPadding := hPadding * (numCols - 1)
-	colWidth := (width - totalPadding) / numCols
+	analytics := AnalyzeContributions(m.contributions)
 
-	// Ensure minimum column width
-	minColWidth := 40
-	if colWidth < minColWidth {
-		colWidth = minColWidth
+	var lines []string
+	lines = append(lines, title, "")
+
+	// Weekday averages as a 7-bar sparkline, Sunday first
+	var weekdayAvgs []int
+	for _, avg := range analytics.WeekdayAverages {
+		weekdayAvgs = append(weekdayAvgs, int(avg*100))
+	}
+	lines = append(lines, labelStyle.Render("By Weekday"))
+	lines = append(lines, accentStyle.Render(strings.Join(strings.Split(sparkline(weekdayAvgs), ""), " ")))
+	lines = append(lines, dimStyle.Render("S M T W T F S"))
+	lines = append(lines, fmt.Sprintf("%s %s %s",
+		labelStyle.Render("Best"),
+		accentStyle.Render(analytics.BestWeekday.String()[:3]),
+		dimStyle.Render(fmt.Sprintf("%.1f/day", analytics.WeekdayAverages[analytics.BestWeekday]))))
+	lines = append(lines, fmt.Sprintf("%s %s %s",
+		labelStyle.Render("Worst"),
+		accentStyle.Render(analytics.WorstWeekday.String()[:3]),
+		dimStyle.Render(fmt.Sprintf("%.1f/day", analytics.WeekdayAverages[analytics.WorstWeekday]))))
+	lines = append(lines, "")
+
+	// Most recent weeks that fit the column
+	weeks := analytics.WeeklyTotals
+	if len(weeks) > contentWidth && contentWidth > 0 {
+		weeks = weeks[len(weeks)-contentWidth:]
+	}
+	lines = append(lines, labelStyle.Render(fmt.Sprintf("Weekly Trend (%dw)", len(weeks))))
+	lines = append(lines, accentStyle.Render(sparkline(weeks)))
+	lines = append(lines, "")
+
+	// Month over month change
+	change := dimStyle.Render("n/a")
+	if analytics.HasMonthOverMonth {
+		change = accentStyle.Render(fmt.Sprintf("%+.0f%%", analytics.MonthOverMonth))
+	}
+	lines = append(lines, labelStyle.Render("Last 30d vs Prior")+" "+change)
+	lines = append(lines, labelStyle.Render("Consistency")+" "+accentStyle.Render(fmt.Sprintf("%d/100", analytics.Consistency)))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return lipgloss.NewStyle().
+		PaddingLeft(hMargin).
+		Render(content)
+}
+
+// focusArea identifies a column of the bottom row, or the Top Repositories panel
+type focusArea int
+
+const (
+	focusActivity focusArea = iota
+	focusPullRequests
+	focusIssues
+	focusNotifications
+	focusTopRepos
+)
+
+// rowColumn is a column of the bottom row
+type rowColumn struct {
+	area   focusArea
+	render func(width, height int) string
+}
+
+// rowColumns returns the bottom row columns that fit in width, in display order.
+// Columns are dropped from the right until each is at least minRowColumnWidth.
+func (m Model) rowColumns(width int) []rowColumn {
+	columns := []rowColumn{{area: focusActivity, render: m.renderActivity}}
+	// Pull requests and issues are searched by author, which organizations aren't
+	if !m.isOrg {
+		columns = append(columns,
+			rowColumn{area: focusPullRequests, render: m.renderPullRequests},
+			rowColumn{area: focusIssues, render: m.renderIssues},
+		)
+	}
+	// Only the authenticated user's own inbox is readable
+	if m.isOwnProfile && !m.offline {
+		columns = append(columns, rowColumn{area: focusNotifications, render: m.renderNotifications})
+	}
+
+	for len(columns) > 1 {
+		_, hPadding := rowColumnWidth(width, len(columns))
+		if (width-hPadding*(len(columns)-1))/len(columns) >= minRowColumnWidth {
+			break
+		}
+		columns = columns[:len(columns)-1]
+	}
+	return columns
+}
+
+// columnVisible reports whether a row column fits at the current width
+// Top Repositories is always shown.
+func (m Model) columnVisible(area focusArea) bool {
+	for _, a := range m.focusOrder() {
+		if a == area {
+			return true
+		}
 	}
+	return false
+}
+
+// focusOrder returns the focusable areas in 'tab' order: the visible row
+// columns, then Top Repositories
+func (m Model) focusOrder() []focusArea {
+	var areas []focusArea
+	for _, col := range m.rowColumns(m.width) {
+		areas = append(areas, col.area)
+	}
+	return append(areas, focusTopRepos)
+}
+
+// cycleFocus moves focus to the next visible row column or Top Repositories
+func (m *Model) cycleFocus() {
+	areas := m.focusOrder()
+	next := 0
+	for i, area := range areas {
+		if area == m.focus {
+			next = (i + 1) % len(areas)
+		}
+	}
+	m.focus = areas[next]
+}
+
+// openFocusedRow opens the selected row of the focused column in the browser
+func (m Model) openFocusedRow() tea.Cmd {
+	switch m.focus {
+	case focusPullRequests:
+		if pr, ok := m.selectedPullRequest(); ok {
+			return openURL(pr.URL)
+		}
+	case focusIssues:
+		if issue, ok := m.selectedIssue(); ok {
+			return openURL(issue.URL)
+		}
+	case focusNotifications:
+		if n, ok := m.selectedNotification(); ok {
+			return openURL(n.URL)
+		}
+	case focusTopRepos:
+		if repo, ok := m.selectedTopRepo(); ok {
+			return openURL(repo.HTMLURL)
+		}
+	default:
+		if activity, ok := m.selectedActivity(); ok {
+			return openURL(activity.WebURL(m.host))
+		}
+	}
+	return nil
+}
+
+// columnTitle renders a focusable panel title, underlined when the panel has focus
+func (m Model) columnTitle(area focusArea, text string) string {
+	if area == m.focus {
+		return titleStyle.Underline(true).Render(text)
+	}
+	return titleStyle.Render(text)
+}
+
+// renderColumnsRow renders a multi-column layout (DRY and scalable)
+// Columns that don't fit are dropped from the right
+func (m Model) renderColumnsRow(width, height int) string {
+	// Columns that fit, in display order (add new columns in rowColumns)
+	columns := m.rowColumns(width)
+
+	numCols := len(columns)
+	if numCols == 0 {
+		return ""
+	}
+
+	colWidth, hPadding := rowColumnWidth(width, numCols)
 
 	// Render each column
 	var renderedColumns []string
@@ -866,7 +1665,18 @@ func (m Model) renderActivity(width, height int) string {
 		hMargin = 2
 	}
 
-	title := titleStyle.Render(fmt.Sprintf("Recent Activity (%d)", len(m.activities)))
+	title := m.columnTitle(focusActivity, fmt.Sprintf("Recent Activity (%d)", len(m.activities)))
+	if m.filter.Active() {
+		title = m.columnTitle(focusActivity, fmt.Sprintf("Recent Activity (%d/%d)", len(m.visibleActivities()), len(m.activities)))
+	}
+
+	// Filter prompt or summary sits between the title and the table header
+	filterLine := ""
+	if m.filtering {
+		filterLine = m.filterInput.View()
+	} else if m.filter.Active() {
+		filterLine = dimStyle.Render("filter: " + m.filter.String() + " (esc clears)")
+	}
 
 	if !m.ready {
 		content := lipgloss.JoinVertical(lipgloss.Left, title, "", labelStyle.Render("Loading..."))
@@ -877,10 +1687,8 @@ func (m Model) renderActivity(width, height int) string {
 	}
 
 	// Render table header (frozen, outside viewport)
-	timeWidth := 10
-	eventWidth := 20
-	repoWidth := 30
-	actionWidth := 40
+	columns := activityColumns()
+	widths := layoutColumns(columns, columnContentWidth(width))
 
 	headerStyle := lipgloss.NewStyle().
 		Foreground(lipgloss.Color(CurrentTheme.Blue)).
@@ -889,26 +1697,11 @@ func (m Model) renderActivity(width, height int) string {
 	borderStyle := lipgloss.NewStyle().
 		Foreground(lipgloss.Color(CurrentTheme.Gray))
 
-	divider := borderStyle.Render("│")
-
-	// Build header with consistent border styling
-	header := fmt.Sprintf("%s %s %s %s %s %s %s",
-		headerStyle.Render(fmt.Sprintf("%-*s", timeWidth, "Time")),
-		divider,
-		headerStyle.Render(fmt.Sprintf("%-*s", eventWidth, "Event")),
-		divider,
-		headerStyle.Render(fmt.Sprintf("%-*s", repoWidth, "Repository")),
-		divider,
-		headerStyle.Render(fmt.Sprintf("%-*s", actionWidth, "Action")),
-	)
-
-	separator := borderStyle.Render(strings.Repeat("─", timeWidth) + "─┼─" +
-		strings.Repeat("─", eventWidth) + "─┼─" +
-		strings.Repeat("─", repoWidth) + "─┼─" +
-		strings.Repeat("─", actionWidth))
+	header := renderTableHeader(columns, widths, headerStyle, borderStyle)
+	separator := renderTableSeparator(widths, borderStyle)
 
 	// Stack: title, header, separator, viewport content
-	content := lipgloss.JoinVertical(lipgloss.Left, title, "", header, separator, m.viewport.View())
+	content := lipgloss.JoinVertical(lipgloss.Left, title, filterLine, header, separator, m.viewport.View())
 	return lipgloss.NewStyle().
 		PaddingLeft(hMargin).
 		PaddingRight(hMargin).
@@ -917,66 +1710,264 @@ func (m Model) renderActivity(width, height int) string {
 
 // renderActivityList creates the activity list content with table styling
 func (m Model) renderActivityList() string {
-	if len(m.activities) == 0 {
-		return labelStyle.Render("No recent activity")
+	lines, _ := m.activityLines()
+	return strings.Join(lines, "\n")
+}
+
+// activityLines renders the activity rows, including expanded details,
+// and returns the line index of the cursor row
+func (m Model) activityLines() ([]string, int) {
+	activities := m.visibleActivities()
+	if len(activities) == 0 {
+		if len(m.activities) > 0 {
+			return []string{labelStyle.Render("No activity matches the filter")}, 0
+		}
+		return []string{labelStyle.Render("No recent activity")}, 0
 	}
 
-	// Column widths (must match renderActivity header)
-	timeWidth := 10
-	eventWidth := 20
-	repoWidth := 30
-	actionWidth := 40
+	// Column widths (shared with the renderActivity header)
+	widths := layoutColumns(activityColumns(), m.viewport.Width)
+	abbreviate := widths[1] > 0 && widths[1] < len("PullRequestReviewEvent")
 
 	var rows []string
-
-	// Styles for colorizing columns
-	timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Cyan))
-	eventStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Yellow))
-	repoStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Green))
-	actionStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Foreground))
-	dividerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Gray))
+	cursorLine := 0
 
 	// Data rows
-	for _, activity := range m.activities {
-		timeAgo := formatTimeAgo(activity.Timestamp)
+	for i, activity := range activities {
+		// Styles for colorizing columns; the cursor row gets a highlighted background
+		timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Cyan))
+		eventStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Yellow))
+		repoStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Green))
+		actionStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Foreground))
+		dividerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Gray))
+		if i == m.activityCursor {
+			cursorLine = len(rows)
+			bg := lipgloss.Color(CurrentTheme.Subtle)
+			timeStyle = timeStyle.Background(bg)
+			eventStyle = eventStyle.Background(bg)
+			repoStyle = repoStyle.Background(bg)
+			actionStyle = actionStyle.Background(bg)
+			dividerStyle = dividerStyle.Background(bg)
+		}
+
 		privacy := ""
 		if !activity.Public {
 			privacy = "[private] "
 		}
-
-		// Truncate to fit columns
 		eventType := activity.Type
-		if len(eventType) > eventWidth {
-			eventType = eventType[:eventWidth-1] + "…"
+		if abbreviate {
+			eventType = strings.TrimSuffix(eventType, "Event")
+		}
+
+		// Build row with colorized, width-fitted columns
+		styles := []lipgloss.Style{timeStyle, eventStyle, repoStyle, actionStyle}
+		line := renderTableRow(
+			[]string{formatTimeAgo(activity.Timestamp, false), eventType, privacy + activity.Repo, activity.Action},
+			widths,
+			func(col int) lipgloss.Style { return styles[col] },
+			dividerStyle,
+		)
+
+		rows = append(rows, line)
+
+		if m.expandedActivities[activityKey(i, activity)] {
+			// Details line up under the second visible column
+			indentWidth := 0
+			if widths[0] > 0 {
+				indentWidth = widths[0] + runewidth.StringWidth(tableSeparator)
+			}
+			indent := strings.Repeat(" ", indentWidth)
+			for _, detail := range m.activityDetailLines(activity, m.viewport.Width-indentWidth) {
+				rows = append(rows, indent+detail)
+			}
 		}
+	}
+
+	return rows, cursorLine
+}
 
-		repo := privacy + activity.Repo
-		if len(repo) > repoWidth {
-			repo = repo[:repoWidth-1] + "…"
+// activityDetailLines renders the expanded details of an activity row
+func (m Model) activityDetailLines(activity Activity, width int) []string {
+	d := activity.Details
+	var lines []string
+
+	if d.Ref != "" {
+		kind := d.RefType
+		if kind == "" {
+			kind = "branch"
 		}
+		lines = append(lines, labelStyle.Render(kind+" ")+accentStyle.Render(d.Ref))
+	}
 
-		action := activity.Action
-		if len(action) > actionWidth {
-			action = action[:actionWidth-1] + "…"
+	if d.Number != 0 {
+		kind := "Issue"
+		if d.IsPR {
+			kind = "PR"
+		}
+		lines = append(lines, accentStyle.Render(truncate(fmt.Sprintf("%s #%d: %s", kind, d.Number, d.Title), width)))
+		if d.Body != "" {
+			lines = append(lines, dimStyle.Render(truncate(d.Body, width)))
 		}
+	} else if d.Tag != "" {
+		lines = append(lines, accentStyle.Render(truncate("Release "+d.Tag+" "+d.Title, width)))
+	}
 
-		// Build row with colorized columns
-		line := fmt.Sprintf("%s %s %s %s %s %s %s",
-			timeStyle.Render(fmt.Sprintf("%-*s", timeWidth, timeAgo)),
-			dividerStyle.Render("│"),
-			eventStyle.Render(fmt.Sprintf("%-*s", eventWidth, eventType)),
-			dividerStyle.Render("│"),
-			repoStyle.Render(fmt.Sprintf("%-*s", repoWidth, repo)),
-			dividerStyle.Render("│"),
-			actionStyle.Render(fmt.Sprintf("%-*s", actionWidth, action)),
-		)
+	// Commits (newest last, as pushed)
+	const maxCommits = 10
+	for i, commit := range d.Commits {
+		if i == maxCommits {
+			lines = append(lines, dimStyle.Render(fmt.Sprintf("… %d more commit(s)", len(d.Commits)-maxCommits)))
+			break
+		}
+		sha := commit.SHA
+		if len(sha) > 7 {
+			sha = sha[:7]
+		}
+		author := ""
+		if commit.Author != "" {
+			author = " — " + commit.Author
+		}
+		lines = append(lines, labelStyle.Render(sha)+" "+
+			baseStyle.Render(truncate(commit.Message, width-len(sha)-runewidth.StringWidth(author)-1))+dimStyle.Render(author))
+	}
 
-		rows = append(rows, line)
+	lines = append(lines, dimStyle.Render(truncate(activity.WebURL(m.host), width)))
+	return lines
+}
+
+// activityKey identifies an activity for expansion state across reloads
+func activityKey(index int, activity Activity) string {
+	if activity.ID != "" {
+		return activity.ID
+	}
+	return fmt.Sprintf("#%d", index)
+}
+
+// moveActivityCursor moves the activity row cursor and keeps it visible
+func (m *Model) moveActivityCursor(delta int) {
+	count := len(m.visibleActivities())
+	if count == 0 {
+		return
+	}
+	m.activityCursor += delta
+	if m.activityCursor < 0 {
+		m.activityCursor = 0
+	}
+	if m.activityCursor >= count {
+		m.activityCursor = count - 1
+	}
+	m.refreshActivityViewport()
+}
+
+// visibleActivities returns the activities that pass the current filter
+func (m Model) visibleActivities() []Activity {
+	return m.filter.Apply(m.activities)
+}
+
+// selectedActivity returns the activity under the row cursor
+func (m Model) selectedActivity() (Activity, bool) {
+	activities := m.visibleActivities()
+	if m.activityCursor < 0 || m.activityCursor >= len(activities) {
+		return Activity{}, false
+	}
+	return activities[m.activityCursor], true
+}
+
+// applyActivityFilter resets the row cursor after the filter changes
+func (m *Model) applyActivityFilter() {
+	m.activityCursor = 0
+	m.refreshActivityViewport()
+	m.viewport.GotoTop()
+}
+
+// updateFilterPrompt handles keys while the filter prompt is open
+// The table filters live as you type; enter keeps the query, esc restores the previous one
+func (m Model) updateFilterPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.filtering = false
+		m.filterInput.Blur()
+		return m, nil
+	case tea.KeyEsc:
+		m.filtering = false
+		m.filterInput.Blur()
+		m.filter.Query = m.filterQueryBefore
+		m.applyActivityFilter()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	m.filter.Query = strings.TrimSpace(m.filterInput.Value())
+	m.applyActivityFilter()
+	return m, cmd
+}
+
+// refreshActivityViewport re-renders the activity rows and scrolls the cursor into view
+func (m *Model) refreshActivityViewport() {
+	if !m.ready {
+		return
+	}
+	lines, cursorLine := m.activityLines()
+	m.viewport.SetContent(strings.Join(lines, "\n"))
+
+	if cursorLine < m.viewport.YOffset {
+		m.viewport.SetYOffset(cursorLine)
+	} else if cursorLine >= m.viewport.YOffset+m.viewport.Height {
+		m.viewport.SetYOffset(cursorLine - m.viewport.Height + 1)
 	}
+}
 
-	return strings.Join(rows, "\n")
+// activityColumns defines the Recent Activity table columns
+// Event type is dropped first on narrow terminals, then the repository
+func activityColumns() []tableColumn {
+	return []tableColumn{
+		{title: "Time", min: 8, max: 10, priority: 3},
+		{title: "Event", short: "Type", min: 8, max: 22, priority: 1},
+		{title: "Repository", short: "Repo", min: 12, max: 30, priority: 2},
+		{title: "Action", min: 16, priority: 4},
+	}
 }
 
+// columnContentWidth returns the table width inside a row column of the given width
+func columnContentWidth(columnWidth int) int {
+	hMargin := 1
+	if columnWidth > 100 {
+		hMargin = 2
+	}
+	return columnWidth - 2*hMargin
+}
+
+// tableViewportWidth returns the width of the row column tables for the terminal width
+func (m Model) tableViewportWidth() int {
+	colWidth, _ := rowColumnWidth(m.width, len(m.rowColumns(m.width)))
+	return columnContentWidth(colWidth)
+}
+
+// minRowColumnWidth is the narrowest a bottom row column is rendered
+const minRowColumnWidth = 40
+
+// rowColumnWidth splits width between numCols columns of the bottom row,
+// returning the width of each column and the padding between them
+func rowColumnWidth(width, numCols int) (colWidth, hPadding int) {
+	// Calculate responsive horizontal padding between columns
+	hPadding = 2
+	if width < 80 {
+		hPadding = 1
+	} else if width > 150 {
+		hPadding = 3
+	}
+
+	// Calculate column width: (total width - total padding) / number of columns
+	totalPadding := hPadding * (numCols - 1)
+	colWidth = (width - totalPadding) / numCols
+
+	// Ensure minimum column width
+	if colWidth < minRowColumnWidth {
+		colWidth = minRowColumnWidth
+	}
+	return colWidth, hPadding
+}
 
 // renderStatusBar renders the bottom status bar with keybindings
 func (m Model) renderStatusBar(width int) string {
@@ -1003,14 +1994,101 @@ func (m Model) renderStatusBar(width int) string {
 	// Build status bar with styled components
 	var parts []string
 
+	// Stale banner when rendering a saved snapshot instead of live data
+	if !m.staleSince.IsZero() {
+		staleStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(CurrentTheme.Yellow)).
+			Background(lipgloss.Color(CurrentTheme.Subtle)).
+			Bold(true)
+		label := "OFFLINE"
+		if !m.offline {
+			label = "NETWORK ERROR"
+		}
+		parts = append(parts, staleStyle.Render(fmt.Sprintf("%s: stale since %s",
+			label, m.staleSince.Local().Format("Jan 2 15:04"))))
+	}
+
+	// One-off notices (e.g. browser launch failures)
+	if m.notice != "" {
+		parts = append(parts, lipgloss.NewStyle().
+			Foreground(lipgloss.Color(CurrentTheme.Yellow)).
+			Background(lipgloss.Color(CurrentTheme.Subtle)).
+			Render(m.notice))
+	}
+
+	// Active GitHub host
+	parts = append(parts, descStyle.Render("host ")+valueStyle.Render(m.host))
+
+	// The repository detail page has its own keys
+	if m.detailRepo != "" {
+		parts = append(parts, keyStyle.Render("esc")+descStyle.Render(": back"),
+			keyStyle.Render("↑↓")+descStyle.Render(": scroll"),
+			keyStyle.Render("o")+descStyle.Render(": open in browser"),
+			keyStyle.Render("r")+descStyle.Render(": reload"),
+			keyStyle.Render("q")+descStyle.Render(": quit"))
+		return statusBarLine(parts, sepStyle, width)
+	}
+
+	// The repository browser has its own keys
+	if m.repoBrowsing {
+		order := m.repoSort.String()
+		if m.repoSortReverse {
+			order += " ↑"
+		}
+		parts = append(parts, keyStyle.Render("b")+descStyle.Render(": back"),
+			keyStyle.Render("↑↓")+descStyle.Render(": select")+
+				sepStyle.Render(" | ")+keyStyle.Render("←→")+descStyle.Render(": page")+
+				sepStyle.Render(" | ")+keyStyle.Render("enter")+descStyle.Render(": details")+
+				sepStyle.Render(" | ")+keyStyle.Render("o")+descStyle.Render(": open"),
+			keyStyle.Render("s")+descStyle.Render(": sort ")+valueStyle.Render("["+strings.ToUpper(order)+"]")+
+				sepStyle.Render(" | ")+keyStyle.Render("S")+descStyle.Render(": reverse"),
+			keyStyle.Render("/")+descStyle.Render(": search")+
+				sepStyle.Render(" | ")+keyStyle.Render("f")+descStyle.Render(": forks")+
+				sepStyle.Render(" | ")+keyStyle.Render("x")+descStyle.Render(": archived")+
+				sepStyle.Render(" | ")+keyStyle.Render("p")+descStyle.Render(": visibility")+
+				sepStyle.Render(" | ")+keyStyle.Render("l")+descStyle.Render(": language")+
+				sepStyle.Render(" | ")+keyStyle.Render("#")+descStyle.Render(": topic"),
+			keyStyle.Render("esc")+descStyle.Render(": clear filters"),
+			keyStyle.Render("q")+descStyle.Render(": quit"))
+		return statusBarLine(parts, sepStyle, width)
+	}
+
 	// q: quit
 	parts = append(parts, keyStyle.Render("q")+descStyle.Render(": quit"))
 
 	// r: refresh
 	parts = append(parts, keyStyle.Render("r")+descStyle.Render(": refresh"))
 
+	// c: graph cursor (movement keys while focused)
+	if m.graphFocus {
+		parts = append(parts, keyStyle.Render("←→↑↓")+descStyle.Render(": move day")+
+			sepStyle.Render(" | ")+keyStyle.Render("esc")+descStyle.Render(": leave graph"))
+	} else {
+		parts = append(parts, keyStyle.Render("c")+descStyle.Render(": graph cursor"))
+	}
+
+	// [ ]: step contribution year
+	if len(m.contribYears) > 0 {
+		year := "LAST 12M"
+		if m.selectedYear != 0 {
+			year = fmt.Sprintf("%d", m.selectedYear)
+		}
+		parts = append(parts, keyStyle.Render("[ ]")+descStyle.Render(": year ")+
+			valueStyle.Render(fmt.Sprintf("[%s]", year)))
+	}
+
 	// g: cycle push stats
-	parts = append(parts, keyStyle.Render("g")+descStyle.Render(": cycle push stats"))
+	parts = append(parts, keyStyle.Render("g")+descStyle.Render(": cycle rate period"))
+	parts = append(parts, keyStyle.Render("a")+descStyle.Render(": streaks/analytics"))
+	parts = append(parts, keyStyle.Render("w")+descStyle.Render(": peak hour window ["+strings.ToUpper(m.timeWindow.String())+"]"))
+
+	// l: language weighting [mode]
+	languageMode := "REPOS"
+	if m.languageMode == LanguagesByBytes {
+		languageMode = "BYTES"
+	}
+	parts = append(parts, keyStyle.Render("l")+descStyle.Render(": languages ")+
+		valueStyle.Render(fmt.Sprintf("[%s]", languageMode)))
 
 	// t: theme [name] (count)
 	themeName := GetCurrentThemeName()
@@ -1033,10 +2111,51 @@ func (m Model) renderStatusBar(width int) string {
 			valueStyle.Render(fmt.Sprintf("[%s]", viewMode)))
 	}
 
-	// ↑↓: scroll activity
-	parts = append(parts, keyStyle.Render("↑↓")+descStyle.Render(": scroll activity"))
+	// API quota per resource (turns yellow when under 10%)
+	if m.rateLimits != nil {
+		for _, rl := range m.rateLimits.All() {
+			style := valueStyle
+			if rl.Limit > 0 && rl.Remaining*10 < rl.Limit {
+				style = style.Foreground(lipgloss.Color(CurrentTheme.Yellow))
+			}
+			parts = append(parts, descStyle.Render(rl.Resource+" ")+
+				style.Render(fmt.Sprintf("%d/%d", rl.Remaining, rl.Limit)))
+		}
+	}
+
+	// /: filter activity, 1-5: toggle categories, s: single repo
+	parts = append(parts, keyStyle.Render("/")+descStyle.Render(": filter")+
+		sepStyle.Render(" | ")+keyStyle.Render("1-5")+descStyle.Render(": push/PR/issue/star/fork")+
+		sepStyle.Render(" | ")+keyStyle.Render("s")+descStyle.Render(": this repo"))
+
+	// ↑↓ / enter / o: rows of the focused column, tab: next column
+	enterDesc := ": open"
+	switch m.focus {
+	case focusActivity:
+		enterDesc = ": expand"
+	case focusTopRepos:
+		enterDesc = ": details"
+	}
+	parts = append(parts, keyStyle.Render("↑↓")+descStyle.Render(": select")+
+		sepStyle.Render(" | ")+keyStyle.Render("enter")+descStyle.Render(enterDesc)+
+		sepStyle.Render(" | ")+keyStyle.Render("o")+descStyle.Render(": open"))
+	parts = append(parts, keyStyle.Render("tab")+descStyle.Render(": next column"))
+	parts = append(parts, keyStyle.Render("b")+descStyle.Render(": browse repos"))
+	columns := m.rowColumns(width)
+	if len(columns) > int(focusIssues) {
+		parts = append(parts, keyStyle.Render("v")+descStyle.Render(": issues "+m.issueQuery.String()))
+	}
+	if m.focus == focusNotifications {
+		parts = append(parts, keyStyle.Render("m")+descStyle.Render(": read")+
+			sepStyle.Render(" | ")+keyStyle.Render("d")+descStyle.Render(": done")+
+			sepStyle.Render(" | ")+keyStyle.Render("u")+descStyle.Render(": unsubscribe"))
+	}
+
+	return statusBarLine(parts, sepStyle, width)
+}
 
-	// Join with separator
+// statusBarLine joins status bar parts with separators into a full-width bar
+func statusBarLine(parts []string, sepStyle lipgloss.Style, width int) string {
 	separator := sepStyle.Render(" | ")
 	help := strings.Join(parts, separator)
 
@@ -1049,6 +2168,182 @@ func (m Model) renderStatusBar(width int) string {
 
 // Helper functions
 
+// handleGraphKey moves the graph cursor, reporting whether the key was consumed
+func (m *Model) handleGraphKey(key string) (bool, tea.Cmd) {
+	switch key {
+	case "left", "h":
+		m.graph.MoveCursor(0, -1)
+	case "right", "l":
+		m.graph.MoveCursor(0, 1)
+	case "up", "k":
+		m.graph.MoveCursor(-1, 0)
+	case "down", "j":
+		m.graph.MoveCursor(1, 0)
+	case "esc", "c":
+		m.graphFocus = false
+		m.graph.ClearCursor()
+		return true, nil
+	default:
+		return false, nil
+	}
+	return true, m.scheduleDayDetail()
+}
+
+// scheduleDayDetail debounces fetching the breakdown for the day under the cursor
+func (m *Model) scheduleDayDetail() tea.Cmd {
+	// Organization calendars are sums; there is no single user to break down
+	if m.isOrg {
+		return nil
+	}
+	day, week, ok := m.graph.Cursor()
+	if !ok {
+		return nil
+	}
+	contrib, ok := m.graph.ContributionAt(day, week)
+	if !ok {
+		return nil
+	}
+	if _, cached := m.dayDetails[contrib.Date.Format("2006-01-02")]; cached {
+		return nil
+	}
+
+	m.detailSeq++
+	seq := m.detailSeq
+	return tea.Tick(250*time.Millisecond, func(time.Time) tea.Msg {
+		return detailTickMsg{seq: seq, date: contrib.Date}
+	})
+}
+
+// stepYear returns the year one step older or newer than the selected one
+// The sequence is: last 12 months (0), then each contribution year, most recent first
+func (m Model) stepYear(older bool) (int, bool) {
+	sequence := append([]int{0}, m.contribYears...)
+
+	index := 0
+	for i, year := range sequence {
+		if year == m.selectedYear {
+			index = i
+			break
+		}
+	}
+
+	if older {
+		index++
+	} else {
+		index--
+	}
+	if index < 0 || index >= len(sequence) {
+		return 0, false
+	}
+	return sequence[index], true
+}
+
+// rebuildGraph replaces the contribution graph with new data
+func (m *Model) rebuildGraph(days []Contribution) {
+	m.graph = NewGraph(days)
+	m.graph.SetScale(m.levelScale)
+	m.graph.SetTitle(m.graphTitle())
+}
+
+// graphTitle returns the contribution graph title for the selected year,
+// followed by the last failed year step if any
+func (m Model) graphTitle() string {
+	title := m.userGraphTitle()
+	if m.isOrg {
+		title = m.orgGraphTitle()
+	}
+	if m.loadingYear {
+		title += dimStyle.Render(" (loading...)")
+	}
+	if m.calendarErr != "" {
+		// Truncated so the title stays within the graph's width
+		title += errorStyle.Render(" — " + truncate(m.calendarErr, 60))
+	}
+	return title
+}
+
+// userGraphTitle returns the user graph title for the selected year
+func (m Model) userGraphTitle() string {
+	if m.selectedYear == 0 {
+		return "Contribution Activity (last 12 months)"
+	}
+	return fmt.Sprintf("Contribution Activity — %d", m.selectedYear)
+}
+
+// yearLabel names a selectable calendar period
+func yearLabel(year int) string {
+	if year == 0 {
+		return "the last 12 months"
+	}
+	return fmt.Sprintf("%d", year)
+}
+
+// yearStep wraps a calendar fetch for '[' / ']' so that a failure returns
+// to the previous year instead of failing the whole dashboard
+func yearStep(fetch tea.Cmd, previous int) tea.Cmd {
+	return func() tea.Msg {
+		msg := fetch()
+		if err, ok := msg.(errMsg); ok {
+			return calendarErrMsg{year: previous, err: err}
+		}
+		return msg
+	}
+}
+
+// startLoad begins a new dashboard load made of cmds, superseding any earlier one
+func (m *Model) startLoad(cmds ...tea.Cmd) tea.Cmd {
+	m.loadSeq++
+	m.loadPending = 0
+	m.loadErr = nil
+	return m.addToLoad(cmds...)
+}
+
+// addToLoad adds fetches to the current dashboard load, tagging their
+// results so they're dropped once a newer load starts
+func (m *Model) addToLoad(cmds ...tea.Cmd) tea.Cmd {
+	seq := m.loadSeq
+	tagged := make([]tea.Cmd, 0, len(cmds))
+	for _, cmd := range cmds {
+		m.loadPending++
+		tagged = append(tagged, func() tea.Msg {
+			return loadMsg{seq: seq, msg: cmd()}
+		})
+	}
+	return tea.Batch(tagged...)
+}
+
+// failLoad ends a load in which a fetch failed, falling back to the last
+// successful snapshot unless it was saved with private data toggled differently
+func (m *Model) failLoad() {
+	if snap, err := LoadSnapshot(m.host, m.username); err == nil && snap.PublicOnly == m.publicOnly {
+		m.applySnapshot(snap)
+		m.snapshotPending = false
+		return
+	}
+	m.err = m.loadErr
+	// Clear all loading flags on error
+	m.loading = loadingState{}
+}
+
+// refetchLanguageBytes drops cached byte stats and refetches them if bytes mode is active
+func (m *Model) refetchLanguageBytes() tea.Cmd {
+	m.languageBytes = nil
+	m.languageBytesErr = nil
+	if m.languageMode != LanguagesByBytes {
+		return nil
+	}
+	m.loadingBytes = true
+	return fetchLanguageBytes(m.client, m.username, m.isOwnProfile && !m.publicOnly)
+}
+
+// setRepositories stores the full repository list and derives languages and top repos from it
+func (m *Model) setRepositories(repos []Repository) {
+	m.allRepos = repos
+	m.languages, m.repoCount = LanguageStatsFromRepositories(repos)
+	m.repositories = TopRepositories(repos, 5)
+	m.moveRepoCursor(0)
+}
+
 // calculateActivityViewportHeight calculates the appropriate viewport height
 // based on available terminal space, accounting for all other UI sections
 func (m Model) calculateActivityViewportHeight() int {
@@ -1058,13 +2353,13 @@ func (m Model) calculateActivityViewportHeight() int {
 
 	// Estimate space taken by other sections:
 	// - Header: ~6 lines
-	// - Graph: ~10 lines
+	// - Graph: ~12 lines (including legend)
 	// - Stats row: ~12 lines
 	// - ASCII username: ~4 lines
 	// - Status bar: 1 line
 	// - Activity title + spacing: 2 lines
 	// - Vertical padding: ~3 lines
-	estimatedOtherContent := 38
+	estimatedOtherContent := 40
 
 	availableHeight := m.height - estimatedOtherContent
 
@@ -1169,30 +2464,41 @@ func CalculatePushStats(activities []Activity, granularity PushGranularity) floa
 	return CalculatePushRate(activities, granularity)
 }
 
-// formatTimeAgo formats a timestamp as "X ago"
-func formatTimeAgo(t time.Time) string {
+// formatTimeAgo formats a timestamp as "X ago", or as a date after a week
+// compact drops the "ago" and counts weeks and years instead, e.g. "3h" or
+// "2w", for narrow table columns
+func formatTimeAgo(t time.Time, compact bool) string {
 	duration := time.Since(t)
 
+	suffix := " ago"
+	if compact {
+		suffix = ""
+	}
+
 	switch {
-	case duration < time.Minute:
+	case duration < time.Minute && !compact:
 		return "just now"
 	case duration < time.Hour:
 		mins := int(duration.Minutes())
-		return fmt.Sprintf("%dm ago", mins)
+		return fmt.Sprintf("%dm%s", mins, suffix)
 	case duration < 24*time.Hour:
 		hours := int(duration.Hours())
-		return fmt.Sprintf("%dh ago", hours)
+		return fmt.Sprintf("%dh%s", hours, suffix)
 	case duration < 7*24*time.Hour:
 		days := int(duration.Hours() / 24)
-		return fmt.Sprintf("%dd ago", days)
-	default:
+		return fmt.Sprintf("%dd%s", days, suffix)
+	case !compact:
 		return t.Format("Jan 2")
+	case duration < 365*24*time.Hour:
+		return fmt.Sprintf("%dw", int(duration.Hours()/(24*7)))
+	default:
+		return fmt.Sprintf("%dy", int(duration.Hours()/(24*365)))
 	}
 }
 
 // Async command functions
 
-func fetchProfile(client *GitHubClient, username string, publicOnly bool) tea.Cmd {
+func fetchProfile(client ProfileSource, username string, publicOnly bool) tea.Cmd {
 	return func() tea.Msg {
 		profile, err := client.FetchProfile(username, publicOnly)
 		if err != nil {
@@ -1202,40 +2508,54 @@ func fetchProfile(client *GitHubClient, username string, publicOnly bool) tea.Cm
 	}
 }
 
-func fetchContributions(client *GitHubClient, username string) tea.Cmd {
+func fetchContributions(client ProfileSource, username string, year int) tea.Cmd {
 	return func() tea.Msg {
-		contributions, err := client.FetchContributions(username)
+		calendar, err := client.FetchContributions(username, year)
 		if err != nil {
 			return errMsg(err)
 		}
-		return contributionsMsg(contributions)
+		return contributionsMsg(calendar)
 	}
 }
 
-func fetchLanguages(client *GitHubClient, username string, publicOnly bool) tea.Cmd {
+func fetchRepositories(client ProfileSource, username string, publicOnly bool) tea.Cmd {
 	return func() tea.Msg {
-		languages, repoCount, err := client.FetchLanguages(username, publicOnly)
+		repositories, err := client.FetchAllRepositories(username, publicOnly)
 		if err != nil {
 			return errMsg(err)
 		}
-		return languagesMsg{
-			languages: languages,
-			repoCount: repoCount,
-		}
+		return repositoriesMsg(repositories)
 	}
 }
 
-func fetchRepositories(client *GitHubClient, username string, publicOnly bool) tea.Cmd {
+func fetchLanguageBytes(client ProfileSource, username string, includePrivate bool) tea.Cmd {
 	return func() tea.Msg {
-		repositories, err := client.FetchTopRepositories(username, publicOnly)
-		if err != nil {
-			return errMsg(err)
+		// Failures only affect the Languages panel, not the whole dashboard
+		languages, err := client.FetchLanguageBytes(username, includePrivate)
+		return languageBytesMsg{languages: languages, err: err}
+	}
+}
+
+// openURL opens url in the system browser (honoring GH_BROWSER / BROWSER)
+func openURL(url string) tea.Cmd {
+	return func() tea.Msg {
+		// Launcher output would corrupt the alt screen, so discard it
+		if err := browser.New("", io.Discard, io.Discard).Browse(url); err != nil {
+			return browseErrMsg{err}
 		}
-		return repositoriesMsg(repositories)
+		return nil
+	}
+}
+
+func fetchDayDetail(client ProfileSource, username string, date time.Time) tea.Cmd {
+	return func() tea.Msg {
+		// Failures only affect the popover, not the whole dashboard
+		detail, err := client.FetchContributionDay(username, date)
+		return dayDetailMsg{date: date, detail: detail, err: err}
 	}
 }
 
-func fetchActivities(client *GitHubClient, username string, publicOnly bool) tea.Cmd {
+func fetchActivities(client ProfileSource, username string, publicOnly bool) tea.Cmd {
 	return func() tea.Msg {
 		activities, err := client.FetchRecentActivity(username, publicOnly)
 		if err != nil {
@@ -1245,10 +2565,46 @@ func fetchActivities(client *GitHubClient, username string, publicOnly bool) tea
 	}
 }
 
-func fetchAvatar(avatarURL string) tea.Cmd {
+func fetchPullRequests(client ProfileSource, username string) tea.Cmd {
+	return func() tea.Msg {
+		prs, err := client.FetchPullRequests(username)
+		return pullRequestsMsg{prs: prs, err: err}
+	}
+}
+
+func fetchIssues(client ProfileSource, username string) tea.Cmd {
+	return func() tea.Msg {
+		issues, err := client.FetchIssues(username)
+		return issuesMsg{issues: issues, err: err}
+	}
+}
+
+func fetchNotifications(client ProfileSource, seq int, since string) tea.Cmd {
+	return func() tea.Msg {
+		poll, err := client.FetchNotifications(since)
+		return notificationsMsg{seq: seq, poll: poll, err: err}
+	}
+}
+
+func notificationAction(client ProfileSource, id, action string) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		switch action {
+		case "read":
+			err = client.MarkNotificationRead(id)
+		case "done":
+			err = client.MarkNotificationDone(id)
+		case "unsubscribe":
+			err = client.UnsubscribeNotification(id)
+		}
+		return notificationActionMsg{id: id, action: action, err: err}
+	}
+}
+
+func fetchAvatar(client ProfileSource, avatarURL string) tea.Cmd {
 	return func() tea.Msg {
 		// Fetch avatar image (80x80 pixels for larger display)
-		img, err := FetchAvatarImage(avatarURL, 80)
+		img, err := client.FetchAvatar(avatarURL, 80)
 		if err != nil {
 			// Don't fail the whole app if avatar fails, just return nil
 			return avatarMsg(nil)
@@ -1270,23 +2626,101 @@ func main() {
 	InitTheme()
 	InitStyles()
 
+	// Parse command-line flags
+	var cfg ClientConfig
+	flag.StringVar(&cfg.Host, "hostname", os.Getenv("GITTUI_HOST"), "GitHub host to use, e.g. a GitHub Enterprise Server hostname (env GITTUI_HOST)")
+	flag.BoolVar(&cfg.NoCache, "no-cache", false, "disable the on-disk response cache")
+	flag.BoolVar(&cfg.Refresh, "refresh", false, "revalidate all cached responses before use")
+	offline := flag.Bool("offline", false, "render the last saved snapshot without touching the network")
+	tzName := flag.String("tz", os.Getenv("GITTUI_TZ"), "time zone for peak hour stats, e.g. Europe/Berlin (env GITTUI_TZ; default local time)")
+	scaleName := flag.String("scale", os.Getenv("GITTUI_SCALE"), "contribution graph levels: github, quantile or fixed (env GITTUI_SCALE)")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "Usage: gittui [flags] [username]")
+		fmt.Fprintln(flag.CommandLine.Output(), "       gittui [flags] org <name>")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	// "gittui org <name>" shows an organization instead of a user
+	args := flag.Args()
+	isOrg := len(args) > 0 && args[0] == "org"
+	if isOrg {
+		if len(args) < 2 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		args = args[1:]
+	}
+
+	if cfg.Host == "" {
+		cfg.Host = defaultHost
+	}
+	levelScale, err := ParseLevelScale(*scaleName)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	location := time.Local
+	if *tzName != "" {
+		if location, err = time.LoadLocation(*tzName); err != nil {
+			fmt.Printf("Error: invalid --tz %q: %v\n", *tzName, err)
+			os.Exit(1)
+		}
+	}
+
+	// Create spinner
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = loadingStyle
+
+	// Offline mode: render the last snapshot and never fetch
+	if *offline {
+		var snap *Snapshot
+		var err error
+		if len(args) > 0 {
+			snap, err = LoadSnapshot(cfg.Host, args[0])
+		} else {
+			snap, err = LoadLatestSnapshot(cfg.Host)
+		}
+		if err != nil {
+			fmt.Printf("Error: no offline snapshot available: %v\n", err)
+			os.Exit(1)
+		}
+
+		m := Model{
+			username:        snap.Username,
+			host:            cfg.Host,
+			publicOnly:      snap.PublicOnly,
+			offline:         true,
+			pushGranularity: PushPerDay,
+			levelScale:      levelScale,
+			location:        location,
+			filterInput:     newFilterInput(),
+			repoSearchInput: newRepoSearchInput(),
+			spinner:         s,
+		}
+		m.applySnapshot(snap)
+		runProgram(m)
+		return
+	}
+
 	// Get username from args or use authenticated user
 	username := ""
-	if len(os.Args) > 1 {
-		username = os.Args[1]
+	if len(args) > 0 {
+		username = args[0]
 	} else {
 		// Get authenticated user from gh CLI
-		username = getAuthenticatedUser()
+		username = getAuthenticatedUser(cfg)
 	}
 
 	if username == "" {
-		fmt.Println("Usage: gittui [username]")
+		fmt.Println("Usage: gittui [flags] [username]")
 		fmt.Println("Or run 'gh auth login' to use your authenticated profile")
 		os.Exit(1)
 	}
 
 	// Create GitHub client
-	client, err := NewGitHubClient()
+	client, err := NewGitHubClient(cfg)
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
@@ -1294,33 +2728,64 @@ func main() {
 
 	// Determine if viewing own profile (check once at startup)
 	isOwnProfile := false
-	if authUser, err := client.FetchAuthenticatedUser(); err == nil {
-		isOwnProfile = (authUser.Login == username)
+	if !isOrg {
+		if authUser, err := client.FetchAuthenticatedUser(); err == nil {
+			isOwnProfile = (authUser.Login == username)
+		}
 	}
 
-	// Create spinner
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = loadingStyle
-
 	// Create initial model
 	m := Model{
 		username:        username,
+		host:            client.Host(),
 		isOwnProfile:    isOwnProfile,
-		publicOnly:      false,          // Default to showing all (private included) for own profile
-		pushGranularity: PushPerDay,     // Default to pushes per day
+		isOrg:           isOrg,
+		publicOnly:      false,      // Default to showing all (private included) for own profile
+		pushGranularity: PushPerDay, // Default to pushes per day
+		languageMode:    LanguagesByRepos,
+		levelScale:      levelScale,
+		location:        location,
+		filterInput:     newFilterInput(),
+		repoSearchInput: newRepoSearchInput(),
 		client:          client,
+		rateLimits:      client.RateLimits(),
 		loading: loadingState{
 			profile:       true,
 			contributions: true,
-			languages:     true,
+			repositories:  true,
 			activities:    true,
 		},
-		spinner: s,
+		loadingPRs:           !isOrg,
+		loadingIssues:        !isOrg,
+		loadingNotifications: isOwnProfile,
+		spinner:              s,
+		snapshotPending:      true,
 	}
 
-	// Run the program
-	p := tea.NewProgram(m, tea.WithAltScreen())
+	runProgram(m)
+}
+
+// newRepoSearchInput creates the repository browser search prompt
+func newRepoSearchInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = "/ "
+	input.Placeholder = "search repositories"
+	input.CharLimit = 100
+	return input
+}
+
+// newFilterInput creates the activity filter prompt
+func newFilterInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = "/ "
+	input.Placeholder = "filter by repo, event or action"
+	input.CharLimit = 100
+	return input
+}
+
+// runProgram runs the Bubble Tea program until the user quits
+func runProgram(m Model) {
+	p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
@@ -1328,8 +2793,8 @@ func main() {
 }
 
 // getAuthenticatedUser gets the authenticated username from gh CLI
-func getAuthenticatedUser() string {
-	client, err := NewGitHubClient()
+func getAuthenticatedUser(cfg ClientConfig) string {
+	client, err := NewGitHubClient(cfg)
 	if err != nil {
 		return ""
 	}
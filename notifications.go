@@ -0,0 +1,265 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// notificationColumns defines the Notifications table columns (the repository is a group header)
+// The reason is dropped first on narrow terminals
+func notificationColumns() []tableColumn {
+	return []tableColumn{
+		{title: "Type", min: 5, max: 10, priority: 3},
+		{title: "Title", min: 12, priority: 5},
+		{title: "Reason", min: 6, max: 16, priority: 2},
+		{title: "Age", min: 4, max: 4, priority: 4},
+	}
+}
+
+// groupNotifications orders notifications by repository, most recently
+// updated repository first, and by update time within each repository
+func groupNotifications(notifications []Notification) []Notification {
+	latest := make(map[string]int64)
+	for _, n := range notifications {
+		if t := n.UpdatedAt.Unix(); t > latest[n.Repo] {
+			latest[n.Repo] = t
+		}
+	}
+
+	grouped := make([]Notification, len(notifications))
+	copy(grouped, notifications)
+	sort.SliceStable(grouped, func(i, j int) bool {
+		a, b := grouped[i], grouped[j]
+		if a.Repo != b.Repo {
+			if latest[a.Repo] != latest[b.Repo] {
+				return latest[a.Repo] > latest[b.Repo]
+			}
+			return a.Repo < b.Repo
+		}
+		return a.UpdatedAt.After(b.UpdatedAt)
+	})
+	return grouped
+}
+
+// notificationType shortens a subject type for the Type column
+func notificationType(subjectType string) string {
+	switch subjectType {
+	case "PullRequest":
+		return "PR"
+	case "CheckSuite":
+		return "CI"
+	default:
+		return subjectType
+	}
+}
+
+// renderNotifications renders the notifications inbox column
+func (m Model) renderNotifications(width, height int) string {
+	hMargin := 1
+	if width > 100 {
+		hMargin = 2
+	}
+
+	unread := 0
+	for _, n := range m.notifications {
+		if n.Unread {
+			unread++
+		}
+	}
+	title := m.columnTitle(focusNotifications, fmt.Sprintf("Notifications (%d)", unread))
+
+	var content string
+	switch {
+	case !m.ready || (m.loadingNotifications && m.notifications == nil):
+		content = lipgloss.JoinVertical(lipgloss.Left, title, "", labelStyle.Render("Loading..."))
+	case m.notificationErr != nil && m.notifications == nil:
+		content = lipgloss.JoinVertical(lipgloss.Left, title, "", errorStyle.Render(wrapLine(m.notificationErr.Error(), columnContentWidth(width))))
+	default:
+		columns := notificationColumns()
+		widths := layoutColumns(columns, columnContentWidth(width))
+
+		headerStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(CurrentTheme.Blue)).
+			Bold(true)
+		borderStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(CurrentTheme.Gray))
+
+		header := renderTableHeader(columns, widths, headerStyle, borderStyle)
+		separator := renderTableSeparator(widths, borderStyle)
+
+		// A failed poll keeps the last inbox and says so above the table
+		status := ""
+		if m.notificationErr != nil {
+			status = errorStyle.Render(truncate("poll failed: "+m.notificationErr.Error(), columnContentWidth(width)))
+		}
+
+		content = lipgloss.JoinVertical(lipgloss.Left, title, status, header, separator, m.notificationViewport.View())
+	}
+
+	return lipgloss.NewStyle().
+		PaddingLeft(hMargin).
+		PaddingRight(hMargin).
+		Render(content)
+}
+
+// notificationLines renders the notification rows under repository headers
+// and returns the line index of the cursor row
+func (m Model) notificationLines() ([]string, int) {
+	if len(m.notifications) == 0 {
+		return []string{labelStyle.Render("Inbox zero")}, 0
+	}
+
+	widths := layoutColumns(notificationColumns(), m.notificationViewport.Width)
+	repoStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Green)).Bold(true)
+
+	var rows []string
+	cursorLine := 0
+	for i, n := range m.notifications {
+		if i == 0 || n.Repo != m.notifications[i-1].Repo {
+			rows = append(rows, repoStyle.Render(truncate(n.Repo, m.notificationViewport.Width)))
+		}
+
+		titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Foreground)).Bold(true)
+		if !n.Unread {
+			titleStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Gray))
+		}
+		styles := []lipgloss.Style{
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Yellow)),
+			titleStyle,
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Purple)),
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Cyan)),
+		}
+		dividerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Gray))
+		if i == m.notificationCursor {
+			cursorLine = len(rows)
+			bg := lipgloss.Color(CurrentTheme.Subtle)
+			for j := range styles {
+				styles[j] = styles[j].Background(bg)
+			}
+			dividerStyle = dividerStyle.Background(bg)
+		}
+
+		rows = append(rows, renderTableRow(
+			[]string{notificationType(n.Type), n.Title, strings.ReplaceAll(n.Reason, "_", " "), formatTimeAgo(n.UpdatedAt, true)},
+			widths,
+			func(col int) lipgloss.Style { return styles[col] },
+			dividerStyle,
+		))
+	}
+	return rows, cursorLine
+}
+
+// moveNotificationCursor moves the notification cursor, clamped to the inbox
+func (m *Model) moveNotificationCursor(delta int) {
+	if len(m.notifications) == 0 {
+		return
+	}
+	m.notificationCursor += delta
+	if m.notificationCursor < 0 {
+		m.notificationCursor = 0
+	}
+	if m.notificationCursor >= len(m.notifications) {
+		m.notificationCursor = len(m.notifications) - 1
+	}
+	m.refreshNotificationViewport()
+}
+
+// selectedNotification returns the notification under the cursor
+func (m Model) selectedNotification() (Notification, bool) {
+	if m.notificationCursor < 0 || m.notificationCursor >= len(m.notifications) {
+		return Notification{}, false
+	}
+	return m.notifications[m.notificationCursor], true
+}
+
+// setNotifications replaces the inbox, keeping the cursor on the same thread when it is still there
+func (m *Model) setNotifications(notifications []Notification) {
+	selected, hadSelection := m.selectedNotification()
+	m.notifications = groupNotifications(notifications)
+	m.notificationCursor = 0
+	if hadSelection {
+		for i, n := range m.notifications {
+			if n.ID == selected.ID {
+				m.notificationCursor = i
+				break
+			}
+		}
+	}
+	m.refreshNotificationViewport()
+}
+
+// refreshNotificationViewport re-renders the notification rows and scrolls the cursor into view
+func (m *Model) refreshNotificationViewport() {
+	if !m.ready {
+		return
+	}
+	lines, cursorLine := m.notificationLines()
+	m.notificationViewport.SetContent(strings.Join(lines, "\n"))
+
+	// Keep the cursor's repository header visible where possible
+	top := cursorLine
+	if c := m.notificationCursor; c < len(m.notifications) && (c == 0 || m.notifications[c].Repo != m.notifications[c-1].Repo) {
+		top--
+	}
+	if top < m.notificationViewport.YOffset {
+		m.notificationViewport.SetYOffset(top)
+	} else if cursorLine >= m.notificationViewport.YOffset+m.notificationViewport.Height {
+		m.notificationViewport.SetYOffset(cursorLine - m.notificationViewport.Height + 1)
+	}
+}
+
+// actOnNotification applies a notification key to the selected thread.
+// The inbox is updated straight away; a failed request is reported as a notice.
+func (m *Model) actOnNotification(key string) tea.Cmd {
+	n, ok := m.selectedNotification()
+	if !ok || m.offline {
+		return nil
+	}
+
+	var action string
+	switch key {
+	case "m":
+		action = "read"
+		m.notifications[m.notificationCursor].Unread = false
+	case "d":
+		action = "done"
+		m.notifications = append(m.notifications[:m.notificationCursor:m.notificationCursor], m.notifications[m.notificationCursor+1:]...)
+		if m.notificationCursor >= len(m.notifications) && m.notificationCursor > 0 {
+			m.notificationCursor--
+		}
+	case "u":
+		action = "unsubscribe"
+		m.notice = "Unsubscribed from " + n.Title
+	default:
+		return nil
+	}
+
+	m.refreshNotificationViewport()
+	return notificationAction(m.client, n.ID, action)
+}
+
+// restartNotificationPoll starts a fresh poll loop, abandoning any pending one
+func (m *Model) restartNotificationPoll() tea.Cmd {
+	if !m.isOwnProfile {
+		return nil
+	}
+	m.notificationSeq++
+	m.loadingNotifications = true
+	return fetchNotifications(m.client, m.notificationSeq, m.notificationsSince)
+}
+
+// notificationActionVerb describes a notification action for error notices
+func notificationActionVerb(action string) string {
+	switch action {
+	case "read":
+		return "mark read"
+	case "done":
+		return "mark done"
+	default:
+		return action
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGroupNotificationsByRepo(t *testing.T) {
+	base := time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC)
+	notifications := []Notification{
+		{ID: "1", Repo: "o/a", UpdatedAt: base.Add(-3 * time.Hour)},
+		{ID: "2", Repo: "o/b", UpdatedAt: base.Add(-1 * time.Hour)},
+		{ID: "3", Repo: "o/a", UpdatedAt: base},
+		{ID: "4", Repo: "o/b", UpdatedAt: base.Add(-5 * time.Hour)},
+	}
+
+	// o/a has the most recent thread, so its group comes first
+	want := []string{"3", "1", "2", "4"}
+	got := groupNotifications(notifications)
+	for i, id := range want {
+		if got[i].ID != id {
+			t.Fatalf("grouped order = %v, want %v", ids(got), want)
+		}
+	}
+}
+
+func ids(notifications []Notification) []string {
+	var out []string
+	for _, n := range notifications {
+		out = append(out, n.ID)
+	}
+	return out
+}
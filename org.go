@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// fetchDashboard returns the commands that load every dashboard section for
+// the profile being viewed, user or organization
+// An organization's calendar is fetched once its profile lists the members.
+func (m Model) fetchDashboard(includePrivate bool) []tea.Cmd {
+	if m.isOrg {
+		return []tea.Cmd{
+			fetchOrgProfile(m.client, m.username),
+			fetchOrgRepositories(m.client, m.username),
+			fetchOrgActivity(m.client, m.username),
+		}
+	}
+	return []tea.Cmd{
+		fetchProfile(m.client, m.username, includePrivate),
+		m.fetchCalendar(),
+		fetchRepositories(m.client, m.username, includePrivate),
+		fetchActivities(m.client, m.username, includePrivate),
+		fetchPullRequests(m.client, m.username),
+		fetchIssues(m.client, m.username),
+	}
+}
+
+// fetchCalendar fetches the contribution calendar for the selected year
+func (m Model) fetchCalendar() tea.Cmd {
+	if m.isOrg {
+		var members []string
+		if m.profile != nil {
+			members = m.profile.Members
+		}
+		return fetchOrgContributions(m.client, members, m.selectedYear)
+	}
+	return fetchContributions(m.client, m.username, m.selectedYear)
+}
+
+// orgGraphTitle returns the organization graph title for the selected year
+func (m Model) orgGraphTitle() string {
+	title := fmt.Sprintf("Member Contributions — %d", m.selectedYear)
+	if m.selectedYear == 0 {
+		title = "Member Contributions (last 12 months)"
+	}
+	if m.contribSkipped > 0 {
+		title += fmt.Sprintf(" (%d members skipped)", m.contribSkipped)
+	}
+	return title
+}
+
+// memberSummary describes whose calendars the organization graph sums
+func (m Model) memberSummary() string {
+	if m.contribMembers == 0 {
+		return "Summed across public members"
+	}
+	summary := fmt.Sprintf("Summed across %d public members", m.contribMembers)
+	if m.profile != nil && len(m.profile.Members) > m.contribMembers {
+		summary += fmt.Sprintf(" (of %d)", len(m.profile.Members))
+	}
+	if m.contribSkipped > 0 {
+		summary += fmt.Sprintf("; %d failed to load", m.contribSkipped)
+	}
+	return summary
+}
+
+func fetchOrgProfile(client ProfileSource, org string) tea.Cmd {
+	return func() tea.Msg {
+		profile, err := client.FetchOrgProfile(org)
+		if err != nil {
+			return errMsg(err)
+		}
+		return profileMsg(profile)
+	}
+}
+
+func fetchOrgContributions(client ProfileSource, members []string, year int) tea.Cmd {
+	return func() tea.Msg {
+		calendar, err := client.FetchOrgContributions(members, year)
+		if err != nil {
+			return errMsg(err)
+		}
+		return contributionsMsg(calendar)
+	}
+}
+
+func fetchOrgRepositories(client ProfileSource, org string) tea.Cmd {
+	return func() tea.Msg {
+		repositories, err := client.FetchOrgRepositories(org)
+		if err != nil {
+			return errMsg(err)
+		}
+		return repositoriesMsg(repositories)
+	}
+}
+
+func fetchOrgActivity(client ProfileSource, org string) tea.Cmd {
+	return func() tea.Msg {
+		activities, err := client.FetchOrgActivity(org)
+		if err != nil {
+			return errMsg(err)
+		}
+		return activitiesMsg(activities)
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// pullRequestColumns defines the Pull Requests table columns
+// Role and review state are dropped first on narrow terminals
+func pullRequestColumns() []tableColumn {
+	return []tableColumn{
+		{title: "CI", min: 2, max: 2, priority: 5},
+		{title: "Pull Request", short: "PR", min: 10, max: 30, priority: 4},
+		{title: "Title", min: 12, priority: 6},
+		{title: "Review", min: 8, max: 9, priority: 2},
+		{title: "Role", min: 8, max: 8, priority: 1},
+		{title: "Age", min: 4, max: 4, priority: 3},
+	}
+}
+
+// renderPullRequests renders the open pull requests column
+func (m Model) renderPullRequests(width, height int) string {
+	hMargin := 1
+	if width > 100 {
+		hMargin = 2
+	}
+
+	title := m.columnTitle(focusPullRequests, fmt.Sprintf("Pull Requests (%d)", len(m.pullRequests)))
+
+	var content string
+	switch {
+	case !m.ready || (m.loadingPRs && m.pullRequests == nil):
+		content = lipgloss.JoinVertical(lipgloss.Left, title, "", labelStyle.Render("Loading..."))
+	case m.prErr != nil && m.pullRequests == nil:
+		content = lipgloss.JoinVertical(lipgloss.Left, title, "", errorStyle.Render(wrapLine(m.prErr.Error(), columnContentWidth(width))))
+	default:
+		columns := pullRequestColumns()
+		widths := layoutColumns(columns, columnContentWidth(width))
+
+		headerStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(CurrentTheme.Blue)).
+			Bold(true)
+		borderStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(CurrentTheme.Gray))
+
+		header := renderTableHeader(columns, widths, headerStyle, borderStyle)
+		separator := renderTableSeparator(widths, borderStyle)
+
+		// Blank line keeps the header level with the activity table's
+		content = lipgloss.JoinVertical(lipgloss.Left, title, "", header, separator, m.prViewport.View())
+	}
+
+	return lipgloss.NewStyle().
+		PaddingLeft(hMargin).
+		PaddingRight(hMargin).
+		Render(content)
+}
+
+// wrapLine joins wrapText's lines for a single-block message
+func wrapLine(text string, width int) string {
+	return strings.Join(wrapText(text, width), "\n")
+}
+
+// prLines renders the pull request rows
+func (m Model) prLines() []string {
+	if len(m.pullRequests) == 0 {
+		return []string{labelStyle.Render("No open pull requests")}
+	}
+
+	widths := layoutColumns(pullRequestColumns(), m.prViewport.Width)
+
+	var rows []string
+	for i, pr := range m.pullRequests {
+		ci, ciColor := ciCell(pr.CIStatus)
+		review, reviewColor := reviewCell(pr)
+
+		styles := []lipgloss.Style{
+			lipgloss.NewStyle().Foreground(lipgloss.Color(ciColor)),
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Green)),
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Foreground)),
+			lipgloss.NewStyle().Foreground(lipgloss.Color(reviewColor)),
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Yellow)),
+			lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Cyan)),
+		}
+		dividerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Gray))
+		if i == m.prCursor {
+			bg := lipgloss.Color(CurrentTheme.Subtle)
+			for j := range styles {
+				styles[j] = styles[j].Background(bg)
+			}
+			dividerStyle = dividerStyle.Background(bg)
+		}
+
+		rows = append(rows, renderTableRow(
+			[]string{ci, fmt.Sprintf("%s#%d", pr.Repo, pr.Number), pr.Title, review, pr.Role, formatTimeAgo(pr.CreatedAt, true)},
+			widths,
+			func(col int) lipgloss.Style { return styles[col] },
+			dividerStyle,
+		))
+	}
+	return rows
+}
+
+// ciCell returns the icon and color for a status check rollup state
+func ciCell(state string) (string, string) {
+	switch state {
+	case "SUCCESS":
+		return "✓", CurrentTheme.Green
+	case "FAILURE", "ERROR":
+		return "✗", CurrentTheme.Red
+	case "PENDING", "EXPECTED":
+		return "●", CurrentTheme.Yellow
+	default:
+		return "·", CurrentTheme.Gray
+	}
+}
+
+// reviewCell returns the review state text and color for a pull request
+func reviewCell(pr PullRequest) (string, string) {
+	switch {
+	case pr.IsDraft:
+		return "draft", CurrentTheme.Gray
+	case pr.ReviewDecision == "APPROVED":
+		return "approved", CurrentTheme.Green
+	case pr.ReviewDecision == "CHANGES_REQUESTED":
+		return "changes", CurrentTheme.Red
+	case pr.ReviewDecision == "REVIEW_REQUIRED":
+		return "review", CurrentTheme.Yellow
+	default:
+		return "", CurrentTheme.Gray
+	}
+}
+
+// movePRCursor moves the pull request cursor, clamped to the list
+func (m *Model) movePRCursor(delta int) {
+	if len(m.pullRequests) == 0 {
+		return
+	}
+	m.prCursor += delta
+	if m.prCursor < 0 {
+		m.prCursor = 0
+	}
+	if m.prCursor >= len(m.pullRequests) {
+		m.prCursor = len(m.pullRequests) - 1
+	}
+	m.refreshPRViewport()
+}
+
+// selectedPullRequest returns the pull request under the cursor
+func (m Model) selectedPullRequest() (PullRequest, bool) {
+	if m.prCursor < 0 || m.prCursor >= len(m.pullRequests) {
+		return PullRequest{}, false
+	}
+	return m.pullRequests[m.prCursor], true
+}
+
+// refreshPRViewport re-renders the pull request rows and scrolls the cursor into view
+func (m *Model) refreshPRViewport() {
+	if !m.ready {
+		return
+	}
+	m.prViewport.SetContent(strings.Join(m.prLines(), "\n"))
+
+	if m.prCursor < m.prViewport.YOffset {
+		m.prViewport.SetYOffset(m.prCursor)
+	} else if m.prCursor >= m.prViewport.YOffset+m.prViewport.Height {
+		m.prViewport.SetYOffset(m.prCursor - m.prViewport.Height + 1)
+	}
+}
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Secondary rate limit backoff settings
+// GitHub usually asks for a minute, and says to wait at least that long
+// when a secondary limit comes without Retry-After or reset headers.
+const (
+	maxRateLimitRetries   = 2
+	maxRetryAfterWait     = 60 * time.Second
+	secondaryLimitBackoff = 60 * time.Second
+)
+
+// RateLimit is the quota state of a single GitHub API resource (core, graphql, search, ...)
+type RateLimit struct {
+	Resource  string
+	Limit     int
+	Remaining int
+	Used      int
+	Reset     time.Time
+	LastCost  int // GraphQL only: point cost of the most recent query
+}
+
+// Exhausted reports whether the quota is used up and not yet reset
+func (rl RateLimit) Exhausted() bool {
+	return rl.Limit > 0 && rl.Remaining == 0 && time.Now().Before(rl.Reset)
+}
+
+// RateLimitTracker records the latest quota seen per resource
+// Safe for concurrent use since fetches run in parallel tea.Cmds
+type RateLimitTracker struct {
+	mu     sync.Mutex
+	limits map[string]RateLimit
+}
+
+// NewRateLimitTracker creates an empty tracker
+func NewRateLimitTracker() *RateLimitTracker {
+	return &RateLimitTracker{limits: make(map[string]RateLimit)}
+}
+
+// Update stores the quota for a resource
+func (t *RateLimitTracker) Update(rl RateLimit) {
+	if rl.Resource == "" {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.limits[rl.Resource] = rl
+}
+
+// Get returns the quota for a resource, if any has been seen
+func (t *RateLimitTracker) Get(resource string) (RateLimit, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rl, ok := t.limits[resource]
+	return rl, ok
+}
+
+// All returns every known quota sorted by resource name
+func (t *RateLimitTracker) All() []RateLimit {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	limits := make([]RateLimit, 0, len(t.limits))
+	for _, rl := range t.limits {
+		limits = append(limits, rl)
+	}
+	sort.Slice(limits, func(i, j int) bool {
+		return limits[i].Resource < limits[j].Resource
+	})
+	return limits
+}
+
+// parseRateLimitHeaders extracts quota from X-RateLimit-* response headers
+func parseRateLimitHeaders(h http.Header) (RateLimit, bool) {
+	limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+	remaining, _ := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	used, _ := strconv.Atoi(h.Get("X-RateLimit-Used"))
+	resetUnix, _ := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+
+	resource := h.Get("X-RateLimit-Resource")
+	if resource == "" {
+		resource = "core"
+	}
+
+	return RateLimit{
+		Resource:  resource,
+		Limit:     limit,
+		Remaining: remaining,
+		Used:      used,
+		Reset:     time.Unix(resetUnix, 0),
+	}, true
+}
+
+// rateLimitTransport records quota headers and backs off on secondary rate limits
+// Each attempt gets its own timeout, so backoff between attempts can outlast it.
+type rateLimitTransport struct {
+	base    http.RoundTripper
+	tracker *RateLimitTracker
+	timeout time.Duration // Per attempt, 0 for none
+	wait    func(ctx context.Context, d time.Duration) error
+}
+
+// newRateLimitTransport wraps base, recording quota into tracker
+func newRateLimitTransport(base http.RoundTripper, tracker *RateLimitTracker) *rateLimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &rateLimitTransport{
+		base:    base,
+		tracker: tracker,
+		timeout: clientTimeout,
+		wait:    sleepContext,
+	}
+}
+
+// RoundTrip performs the request, retrying after Retry-After on secondary rate limits
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.attempt(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if rl, ok := parseRateLimitHeaders(resp.Header); ok {
+			t.tracker.Update(rl)
+		}
+
+		wait, retryable := rateLimitBackoff(resp)
+		if !retryable || attempt >= maxRateLimitRetries || wait > maxRetryAfterWait {
+			return resp, nil
+		}
+		// Return the rate limit response rather than time out mid-wait
+		if deadline, ok := req.Context().Deadline(); ok && time.Until(deadline) <= wait {
+			return resp, nil
+		}
+
+		// Request bodies (GraphQL POSTs) must be replayable to retry
+		if req.Body != nil {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		resp.Body.Close()
+		if err := t.wait(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt sends req once, bounded by the per-attempt timeout
+// The timeout also covers reading the body; closing it releases the timer.
+func (t *rateLimitTransport) attempt(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose cancels an attempt's context once its body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// rateLimitBackoff reports how long to wait before retrying a rate limited
+// response, following GitHub's guidance: Retry-After if present, else the
+// quota reset when none remains, else a minute for secondary limits
+func rateLimitBackoff(resp *http.Response) (time.Duration, bool) {
+	if wait, ok := retryAfter(resp); ok {
+		return wait, true
+	}
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil && reset > 0 {
+			return max(0, time.Until(time.Unix(reset, 0))) + time.Second, true
+		}
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || isSecondaryRateLimit(resp) {
+		return secondaryLimitBackoff, true
+	}
+	return 0, false
+}
+
+// isSecondaryRateLimit reports whether a 403 is a secondary rate limit
+// rather than a permission error; only the message tells them apart.
+// The body is buffered so callers can still read it.
+func isSecondaryRateLimit(resp *http.Response) bool {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return err == nil && strings.Contains(strings.ToLower(string(body)), "secondary rate limit")
+}
+
+// sleepContext waits for d, returning early with the context's error if it is done first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retryAfter reports how long to wait for a secondary rate limit response
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// apiError builds a human-readable error for a failed API response
+// Rate limit exhaustion says which resource ran out and when it resets
+func apiError(resp *http.Response) error {
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+		if rl, ok := parseRateLimitHeaders(resp.Header); ok && rl.Remaining == 0 {
+			return fmt.Errorf("GitHub API rate limit exceeded (%s: 0/%d); resets at %s (in %s)",
+				rl.Resource, rl.Limit, rl.Reset.Local().Format("15:04"), formatUntil(rl.Reset))
+		}
+		if wait, ok := retryAfter(resp); ok {
+			return fmt.Errorf("GitHub secondary rate limit hit; retry in %s", wait)
+		}
+	}
+	return fmt.Errorf("GitHub API error: %s", resp.Status)
+}
+
+// formatUntil formats the time remaining until t as a short duration
+func formatUntil(t time.Time) string {
+	d := time.Until(t).Round(time.Minute)
+	if d < time.Minute {
+		return "<1m"
+	}
+	if d < time.Hour {
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+	return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+}
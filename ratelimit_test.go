@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRateLimitTransportRecordsAndRetries(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-RateLimit-Resource", "core")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(5000-calls))
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		if calls == 1 {
+			// Secondary rate limit
+			w.Header().Set("Retry-After", "3")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	tracker := NewRateLimitTracker()
+	transport := newRateLimitTransport(http.DefaultTransport, tracker)
+	var slept time.Duration
+	transport.wait = func(ctx context.Context, d time.Duration) error {
+		slept += d
+		return nil
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || calls != 2 {
+		t.Errorf("expected retry to succeed, got status %d after %d calls", resp.StatusCode, calls)
+	}
+	if slept != 3*time.Second {
+		t.Errorf("expected to honor Retry-After of 3s, slept %s", slept)
+	}
+
+	rl, ok := tracker.Get("core")
+	if !ok || rl.Remaining != 4998 || rl.Limit != 5000 {
+		t.Errorf("unexpected tracked quota: %+v (ok=%v)", rl, ok)
+	}
+}
+
+func TestRateLimitTransportTimesOutEachAttempt(t *testing.T) {
+	calls := 0
+	slow := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if slow {
+			time.Sleep(time.Second)
+		}
+		if calls == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	transport := newRateLimitTransport(http.DefaultTransport, NewRateLimitTracker())
+	transport.timeout = 500 * time.Millisecond
+	client := &http.Client{Transport: transport}
+
+	// The backoff outlasts the per-attempt timeout and is still slept out
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls != 2 || string(body) != "{}" {
+		t.Errorf("expected retry to succeed, got status %d %q after %d calls", resp.StatusCode, body, calls)
+	}
+
+	// A single slow attempt times out
+	slow = true
+	if _, err := client.Get(server.URL); err == nil {
+		t.Error("expected the slow attempt to time out")
+	}
+}
+
+func TestRateLimitBackoffFallbacks(t *testing.T) {
+	reset := strconv.FormatInt(time.Now().Add(30*time.Second).Unix(), 10)
+	cases := []struct {
+		name    string
+		status  int
+		header  map[string]string
+		body    string
+		want    time.Duration
+		retries bool
+	}{
+		{"retry-after", http.StatusForbidden, map[string]string{"Retry-After": "7"}, "", 7 * time.Second, true},
+		{"quota reset", http.StatusForbidden, map[string]string{"X-RateLimit-Limit": "5000", "X-RateLimit-Remaining": "0", "X-RateLimit-Reset": reset}, "", 31 * time.Second, true},
+		{"bare 429", http.StatusTooManyRequests, nil, "", secondaryLimitBackoff, true},
+		{"secondary 403", http.StatusForbidden, nil, `{"message":"You have exceeded a secondary rate limit."}`, secondaryLimitBackoff, true},
+		{"permission 403", http.StatusForbidden, nil, `{"message":"Resource not accessible"}`, 0, false},
+		{"not found", http.StatusNotFound, nil, "", 0, false},
+	}
+	for _, c := range cases {
+		resp := &http.Response{StatusCode: c.status, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(c.body))}
+		for k, v := range c.header {
+			resp.Header.Set(k, v)
+		}
+		wait, retries := rateLimitBackoff(resp)
+		// Reset times have second precision
+		if diff := wait - c.want; retries != c.retries || diff < -time.Second || diff > time.Second {
+			t.Errorf("%s: backoff = %s, %v; want %s, %v", c.name, wait, retries, c.want, c.retries)
+		}
+		// The body stays readable for error reporting
+		if body, _ := io.ReadAll(resp.Body); string(body) != c.body {
+			t.Errorf("%s: body = %q after backoff check", c.name, body)
+		}
+	}
+}
+
+func TestSleepContextStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := sleepContext(ctx, time.Minute); err != context.DeadlineExceeded {
+		t.Errorf("sleepContext = %v, want deadline exceeded", err)
+	}
+}
+
+func TestAPIErrorExplainsExhaustion(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusForbidden,
+		Status:     "403 Forbidden",
+		Header:     http.Header{},
+	}
+	resp.Header.Set("X-RateLimit-Resource", "search")
+	resp.Header.Set("X-RateLimit-Limit", "30")
+	resp.Header.Set("X-RateLimit-Remaining", "0")
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(5*time.Minute).Unix(), 10))
+
+	msg := apiError(resp).Error()
+	if !strings.Contains(msg, "rate limit exceeded (search: 0/30)") || !strings.Contains(msg, "resets at") {
+		t.Errorf("unexpected error message: %s", msg)
+	}
+}
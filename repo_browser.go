@@ -0,0 +1,537 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RepoSort is the order of the repository browser
+type RepoSort int
+
+const (
+	SortByStars RepoSort = iota
+	SortByForks
+	SortByPushed
+	SortByName
+	SortBySize
+	SortByIssues
+)
+
+// repoSorts is the 's' key cycle order
+var repoSorts = []RepoSort{SortByStars, SortByForks, SortByPushed, SortByName, SortBySize, SortByIssues}
+
+// String returns the display name for a sort key
+func (s RepoSort) String() string {
+	switch s {
+	case SortByForks:
+		return "forks"
+	case SortByPushed:
+		return "last push"
+	case SortByName:
+		return "name"
+	case SortBySize:
+		return "size"
+	case SortByIssues:
+		return "open issues"
+	default:
+		return "stars"
+	}
+}
+
+// Next returns the next sort key in the 's' key cycle
+func (s RepoSort) Next() RepoSort {
+	return repoSorts[(int(s)+1)%len(repoSorts)]
+}
+
+// less reports whether a sorts before b in the key's natural order:
+// names A-Z, everything else largest or most recent first
+func (s RepoSort) less(a, b Repository) bool {
+	switch s {
+	case SortByForks:
+		return a.Forks > b.Forks
+	case SortByPushed:
+		return a.PushedAt.After(b.PushedAt)
+	case SortByName:
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	case SortBySize:
+		return a.Size > b.Size
+	case SortByIssues:
+		return a.OpenIssues > b.OpenIssues
+	default:
+		return a.Stars > b.Stars
+	}
+}
+
+// SortRepositories returns a copy of repos in the key's natural order, or
+// reversed. Ties keep the API order.
+func SortRepositories(repos []Repository, key RepoSort, reverse bool) []Repository {
+	sorted := make([]Repository, len(repos))
+	copy(sorted, repos)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if reverse {
+			return key.less(sorted[j], sorted[i])
+		}
+		return key.less(sorted[i], sorted[j])
+	})
+	return sorted
+}
+
+// RepoVisibility restricts the repository browser to public or private repositories
+type RepoVisibility int
+
+const (
+	VisibilityAll RepoVisibility = iota
+	VisibilityPublic
+	VisibilityPrivate
+)
+
+// String returns the display name for a visibility
+func (v RepoVisibility) String() string {
+	switch v {
+	case VisibilityPublic:
+		return "public"
+	case VisibilityPrivate:
+		return "private"
+	default:
+		return "all"
+	}
+}
+
+// RepoFilter narrows the repository browser
+type RepoFilter struct {
+	Query        string // Fuzzy match on the name, or substring of the description or topics
+	HideForks    bool
+	HideArchived bool
+	Visibility   RepoVisibility
+	Language     string // Only repositories in this language, if set
+	Topic        string // Only repositories with this topic, if set
+}
+
+// Active reports whether the filter hides anything
+func (f RepoFilter) Active() bool {
+	return f != (RepoFilter{})
+}
+
+// Match reports whether a repository passes the filter
+func (f RepoFilter) Match(repo Repository) bool {
+	switch {
+	case f.HideForks && repo.Fork,
+		f.HideArchived && repo.Archived,
+		f.Visibility == VisibilityPublic && repo.Private,
+		f.Visibility == VisibilityPrivate && !repo.Private,
+		f.Language != "" && !strings.EqualFold(repo.Language, f.Language):
+		return false
+	}
+	if f.Topic != "" && !containsFold(repo.Topics, f.Topic) {
+		return false
+	}
+	if f.Query == "" {
+		return true
+	}
+	query := strings.ToLower(f.Query)
+	if fuzzyMatch(query, strings.ToLower(repo.Name)) || strings.Contains(strings.ToLower(repo.Description), query) {
+		return true
+	}
+	for _, topic := range repo.Topics {
+		if strings.Contains(strings.ToLower(topic), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply returns the repositories that pass the filter
+func (f RepoFilter) Apply(repos []Repository) []Repository {
+	if !f.Active() {
+		return repos
+	}
+	var matched []Repository
+	for _, repo := range repos {
+		if f.Match(repo) {
+			matched = append(matched, repo)
+		}
+	}
+	return matched
+}
+
+// String summarises the active filter, e.g. `"cli" Go #tui, no forks`
+func (f RepoFilter) String() string {
+	var parts []string
+	if f.Query != "" {
+		parts = append(parts, fmt.Sprintf("%q", f.Query))
+	}
+	if f.Language != "" {
+		parts = append(parts, f.Language)
+	}
+	if f.Topic != "" {
+		parts = append(parts, "#"+f.Topic)
+	}
+	if f.Visibility != VisibilityAll {
+		parts = append(parts, f.Visibility.String()+" only")
+	}
+	var hidden []string
+	if f.HideForks {
+		hidden = append(hidden, "forks")
+	}
+	if f.HideArchived {
+		hidden = append(hidden, "archived")
+	}
+	if len(hidden) > 0 {
+		parts = append(parts, "hiding "+strings.Join(hidden, ", "))
+	}
+	return strings.Join(parts, " ")
+}
+
+// fuzzyMatch reports whether every rune of pattern appears in s, in order
+func fuzzyMatch(pattern, s string) bool {
+	for _, r := range pattern {
+		i := strings.IndexRune(s, r)
+		if i < 0 {
+			return false
+		}
+		s = s[i+utf8.RuneLen(r):]
+	}
+	return true
+}
+
+// containsFold reports whether values contains s, ignoring case
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// cycleValue returns the value after current in values, then "" (no filter) after the last
+func cycleValue(values []string, current string) string {
+	for i, v := range values {
+		if strings.EqualFold(v, current) {
+			if i+1 < len(values) {
+				return values[i+1]
+			}
+			return ""
+		}
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// repoLanguages returns the languages of repos, most used first
+func repoLanguages(repos []Repository) []string {
+	counts := make(map[string]int)
+	for _, repo := range repos {
+		if repo.Language != "" {
+			counts[repo.Language]++
+		}
+	}
+	return byCount(counts)
+}
+
+// repoTopics returns the topics of repos, most used first
+func repoTopics(repos []Repository) []string {
+	counts := make(map[string]int)
+	for _, repo := range repos {
+		for _, topic := range repo.Topics {
+			counts[topic]++
+		}
+	}
+	return byCount(counts)
+}
+
+// byCount returns the keys of counts, highest count first, then alphabetically
+func byCount(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// repoBrowserColumns defines the repository browser table columns
+// Size and forks are dropped first on narrow terminals
+func repoBrowserColumns() []tableColumn {
+	return []tableColumn{
+		{title: "Repository", short: "Repo", min: 12, max: 40, priority: 7},
+		{title: "Description", min: 12, priority: 2},
+		{title: "Language", short: "Lang", min: 6, max: 12, priority: 4},
+		{title: "Stars", short: "★", min: 5, max: 6, priority: 6},
+		{title: "Forks", min: 5, max: 5, priority: 3},
+		{title: "Issues", min: 6, max: 6, priority: 3},
+		{title: "Size", min: 6, max: 7, priority: 1},
+		{title: "Pushed", min: 6, max: 6, priority: 5},
+	}
+}
+
+// browsedRepos returns every repository passing the browser filter, in browser order
+func (m Model) browsedRepos() []Repository {
+	return SortRepositories(m.repoFilter.Apply(m.allRepos), m.repoSort, m.repoSortReverse)
+}
+
+// selectedBrowsedRepo returns the repository under the browser cursor
+func (m Model) selectedBrowsedRepo() (Repository, bool) {
+	repos := m.browsedRepos()
+	if m.repoBrowserCursor < 0 || m.repoBrowserCursor >= len(repos) {
+		return Repository{}, false
+	}
+	return repos[m.repoBrowserCursor], true
+}
+
+// moveRepoBrowserCursor moves the browser cursor, clamped to the listed repositories
+func (m *Model) moveRepoBrowserCursor(delta int) {
+	count := len(m.browsedRepos())
+	m.repoBrowserCursor += delta
+	if m.repoBrowserCursor >= count {
+		m.repoBrowserCursor = count - 1
+	}
+	if m.repoBrowserCursor < 0 {
+		m.repoBrowserCursor = 0
+	}
+}
+
+// repoBrowserPageSize is how many rows fit on a page of the browser
+func (m Model) repoBrowserPageSize() int {
+	// Blank, title, filter line, table header and separator, page line, status bar
+	return max(1, m.height-6-lipgloss.Height(m.renderStatusBar(m.width)))
+}
+
+// updateRepoBrowser handles keys while the repository browser is open
+func (m Model) updateRepoBrowser(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.repoSearching {
+		return m.updateRepoSearchPrompt(msg)
+	}
+
+	languages := repoLanguages(m.allRepos)
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "b", "B":
+		m.repoBrowsing = false
+		return m, nil
+	case "esc":
+		// Clear filters first, then leave
+		if m.repoFilter.Active() {
+			m.repoFilter = RepoFilter{}
+			m.repoBrowserCursor = 0
+			return m, nil
+		}
+		m.repoBrowsing = false
+		return m, nil
+	case "up", "k":
+		m.moveRepoBrowserCursor(-1)
+	case "down", "j":
+		m.moveRepoBrowserCursor(1)
+	case "pgup", "left":
+		m.moveRepoBrowserCursor(-m.repoBrowserPageSize())
+	case "pgdown", "right":
+		m.moveRepoBrowserCursor(m.repoBrowserPageSize())
+	case "home", "g":
+		m.repoBrowserCursor = 0
+	case "end", "G":
+		m.moveRepoBrowserCursor(len(m.allRepos))
+	case "s":
+		m.repoSort = m.repoSort.Next()
+		m.repoBrowserCursor = 0
+	case "S":
+		m.repoSortReverse = !m.repoSortReverse
+		m.repoBrowserCursor = 0
+	case "f", "F":
+		m.repoFilter.HideForks = !m.repoFilter.HideForks
+		m.repoBrowserCursor = 0
+	case "x", "X":
+		m.repoFilter.HideArchived = !m.repoFilter.HideArchived
+		m.repoBrowserCursor = 0
+	case "p", "P":
+		m.repoFilter.Visibility = (m.repoFilter.Visibility + 1) % 3
+		m.repoBrowserCursor = 0
+	case "l", "L":
+		m.repoFilter.Language = cycleValue(languages, m.repoFilter.Language)
+		m.repoBrowserCursor = 0
+	case "#":
+		m.repoFilter.Topic = cycleValue(repoTopics(m.allRepos), m.repoFilter.Topic)
+		m.repoBrowserCursor = 0
+	case "/":
+		m.repoSearching = true
+		m.repoQueryBefore = m.repoFilter.Query
+		m.repoSearchInput.SetValue(m.repoFilter.Query)
+		m.repoSearchInput.CursorEnd()
+		return m, m.repoSearchInput.Focus()
+	case "enter":
+		if repo, ok := m.selectedBrowsedRepo(); ok {
+			cmd := m.openRepoDetail(repo.FullName)
+			return m, cmd
+		}
+	case "o", "O":
+		if repo, ok := m.selectedBrowsedRepo(); ok {
+			return m, openURL(repo.HTMLURL)
+		}
+	}
+	return m, nil
+}
+
+// updateRepoSearchPrompt handles keys while the browser search prompt is open
+// The list filters live as you type; enter keeps the query, esc restores the previous one
+func (m Model) updateRepoSearchPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.repoSearching = false
+		m.repoSearchInput.Blur()
+		return m, nil
+	case tea.KeyEsc:
+		m.repoSearching = false
+		m.repoSearchInput.Blur()
+		m.repoFilter.Query = m.repoQueryBefore
+		m.repoBrowserCursor = 0
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.repoSearchInput, cmd = m.repoSearchInput.Update(msg)
+	m.repoFilter.Query = strings.TrimSpace(m.repoSearchInput.Value())
+	m.repoBrowserCursor = 0
+	return m, cmd
+}
+
+// renderRepoBrowser renders the full-screen repository browser
+func (m Model) renderRepoBrowser() string {
+	width := m.width - repoDetailMargin*2
+	repos := m.browsedRepos()
+
+	order := m.repoSort.String()
+	if m.repoSortReverse {
+		order += ", reversed"
+	}
+	count := fmt.Sprintf("%d", len(m.allRepos))
+	if m.repoFilter.Active() {
+		count = fmt.Sprintf("%d/%d", len(repos), len(m.allRepos))
+	}
+	title := titleStyle.Render("Repositories ("+count+")") + dimStyle.Render(" sorted by "+order)
+
+	filterLine := ""
+	if m.repoSearching {
+		filterLine = m.repoSearchInput.View()
+	} else if m.repoFilter.Active() {
+		filterLine = dimStyle.Render("filter: " + m.repoFilter.String() + " (esc clears)")
+	}
+
+	columns := repoBrowserColumns()
+	widths := layoutColumns(columns, width)
+	headerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(CurrentTheme.Blue)).
+		Bold(true)
+	borderStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(CurrentTheme.Gray))
+
+	lines := []string{"", title, filterLine,
+		renderTableHeader(columns, widths, headerStyle, borderStyle),
+		renderTableSeparator(widths, borderStyle),
+	}
+
+	// Only the page holding the cursor is shown
+	pageSize := m.repoBrowserPageSize()
+	page := m.repoBrowserCursor / pageSize
+	pages := max(1, (len(repos)+pageSize-1)/pageSize)
+	start := page * pageSize
+	end := min(start+pageSize, len(repos))
+
+	if len(repos) == 0 {
+		lines = append(lines, labelStyle.Render("No matching repositories"))
+	}
+	for i := start; i < end; i++ {
+		lines = append(lines, m.repoBrowserRow(repos[i], widths, i == m.repoBrowserCursor))
+	}
+	for i := end - start; i < pageSize; i++ {
+		lines = append(lines, "")
+	}
+	lines = append(lines, dimStyle.Render(fmt.Sprintf("page %d/%d", page+1, pages)))
+
+	body := lipgloss.NewStyle().
+		PaddingLeft(repoDetailMargin).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.JoinVertical(lipgloss.Left, body, m.renderStatusBar(m.width))
+}
+
+// repoBrowserRow renders one repository row of the browser table
+func (m Model) repoBrowserRow(repo Repository, widths []int, selected bool) string {
+	name := repo.Name
+	if repo.Owner.Login != "" && !strings.EqualFold(repo.Owner.Login, m.username) {
+		// Organization and collaborator repositories keep their owner
+		name = repo.FullName
+	}
+	var badges []string
+	if repo.Private {
+		badges = append(badges, "private")
+	}
+	if repo.Fork {
+		badges = append(badges, "fork")
+	}
+	if repo.Archived {
+		badges = append(badges, "archived")
+	}
+	if len(badges) > 0 {
+		name += " [" + strings.Join(badges, ", ") + "]"
+	}
+
+	pushed := ""
+	if !repo.PushedAt.IsZero() {
+		pushed = formatTimeAgo(repo.PushedAt, true)
+	}
+
+	cells := []string{
+		name,
+		repo.Description,
+		repo.Language,
+		fmt.Sprintf("%d", repo.Stars),
+		fmt.Sprintf("%d", repo.Forks),
+		fmt.Sprintf("%d", repo.OpenIssues),
+		formatRepoSize(repo.Size),
+		pushed,
+	}
+
+	styles := []lipgloss.Style{
+		lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Green)),
+		lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Foreground)),
+		lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.LanguageColor(repo.Language, getLanguageColor(repo.Language)))),
+		lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Yellow)),
+		lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Foreground)),
+		lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Foreground)),
+		lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Gray)),
+		lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Cyan)),
+	}
+	dividerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(CurrentTheme.Gray))
+	if selected {
+		bg := lipgloss.Color(CurrentTheme.Subtle)
+		for i := range styles {
+			styles[i] = styles[i].Background(bg)
+		}
+		dividerStyle = dividerStyle.Background(bg)
+	}
+
+	return renderTableRow(cells, widths, func(col int) lipgloss.Style { return styles[col] }, dividerStyle)
+}
+
+// formatRepoSize formats a repository size given in kilobytes
+func formatRepoSize(kb int) string {
+	switch {
+	case kb < 1024:
+		return fmt.Sprintf("%d KB", kb)
+	case kb < 1024*1024:
+		return fmt.Sprintf("%.1f MB", float64(kb)/1024)
+	default:
+		return fmt.Sprintf("%.1f GB", float64(kb)/(1024*1024))
+	}
+}
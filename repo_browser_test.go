@@ -0,0 +1,98 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRepoFilter(t *testing.T) {
+	repos := []Repository{
+		{Name: "gittui", Language: "Go", Topics: []string{"tui", "github"}},
+		{Name: "dotfiles", Language: "Shell", Description: "My terminal setup"},
+		{Name: "go-github", Language: "Go", Fork: true},
+		{Name: "old-site", Language: "HTML", Archived: true, Private: true},
+	}
+
+	var f RepoFilter
+	if f.Active() || len(f.Apply(repos)) != 4 {
+		t.Fatal("empty filter should pass everything")
+	}
+
+	// Fuzzy on the name, substring on the description and topics
+	f.Query = "GTUI"
+	if got := f.Apply(repos); len(got) != 1 || got[0].Name != "gittui" {
+		t.Errorf("fuzzy query matched %+v", got)
+	}
+	f.Query = "terminal"
+	if got := f.Apply(repos); len(got) != 1 || got[0].Name != "dotfiles" {
+		t.Errorf("description query matched %+v", got)
+	}
+
+	f = RepoFilter{Language: "go", HideForks: true}
+	if got := f.Apply(repos); len(got) != 1 || got[0].Name != "gittui" {
+		t.Errorf("language without forks matched %+v", got)
+	}
+
+	f = RepoFilter{Topic: "TUI"}
+	if got := f.Apply(repos); len(got) != 1 || got[0].Name != "gittui" {
+		t.Errorf("topic matched %+v", got)
+	}
+
+	f = RepoFilter{Visibility: VisibilityPrivate}
+	if got := f.Apply(repos); len(got) != 1 || got[0].Name != "old-site" {
+		t.Errorf("private matched %+v", got)
+	}
+	f.HideArchived = true
+	if got := f.Apply(repos); len(got) != 0 {
+		t.Errorf("private without archived matched %+v", got)
+	}
+}
+
+func TestSortRepositories(t *testing.T) {
+	now := time.Date(2025, 3, 3, 12, 0, 0, 0, time.UTC)
+	repos := []Repository{
+		{Name: "b", Stars: 5, Size: 10, PushedAt: now.Add(-time.Hour)},
+		{Name: "C", Stars: 1, Size: 300, PushedAt: now},
+		{Name: "a", Stars: 5, Size: 20, PushedAt: now.Add(-48 * time.Hour)},
+	}
+
+	names := func(repos []Repository) string {
+		var s string
+		for _, repo := range repos {
+			s += repo.Name
+		}
+		return s
+	}
+
+	cases := []struct {
+		key     RepoSort
+		reverse bool
+		want    string
+	}{
+		{SortByStars, false, "baC"}, // Ties keep the API order
+		{SortByName, false, "abC"},  // Case-insensitive
+		{SortByName, true, "Cba"},
+		{SortByPushed, false, "Cba"},
+		{SortBySize, true, "baC"},
+	}
+	for _, c := range cases {
+		if got := names(SortRepositories(repos, c.key, c.reverse)); got != c.want {
+			t.Errorf("sort by %s (reverse %v) = %s, want %s", c.key, c.reverse, got, c.want)
+		}
+	}
+	if names(repos) != "bCa" {
+		t.Error("SortRepositories modified its input")
+	}
+}
+
+func TestCycleValue(t *testing.T) {
+	values := []string{"Go", "Shell"}
+	want := []string{"Go", "Shell", "", "Go"}
+	current := ""
+	for _, w := range want {
+		current = cycleValue(values, current)
+		if current != w {
+			t.Fatalf("cycleValue = %q, want %q", current, w)
+		}
+	}
+}
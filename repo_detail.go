@@ -0,0 +1,396 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// topReposShown is how many repositories the Top Repositories panel lists
+const topReposShown = 3
+
+// repoDetailMsg carries a fetched repository detail page
+type repoDetailMsg struct {
+	name   string
+	detail *RepoDetail
+	err    error
+}
+
+func fetchRepoDetail(client ProfileSource, fullName string) tea.Cmd {
+	return func() tea.Msg {
+		// Failures only affect the detail page, not the whole dashboard
+		detail, err := client.FetchRepoDetail(fullName)
+		return repoDetailMsg{name: fullName, detail: detail, err: err}
+	}
+}
+
+// displayedTopRepos returns the repositories listed under Top Repositories
+func (m Model) displayedTopRepos() []Repository {
+	if len(m.repositories) > topReposShown {
+		return m.repositories[:topReposShown]
+	}
+	return m.repositories
+}
+
+// moveRepoCursor moves the Top Repositories cursor, clamped to the listed repositories
+func (m *Model) moveRepoCursor(delta int) {
+	repos := m.displayedTopRepos()
+	if len(repos) == 0 {
+		return
+	}
+	m.repoCursor += delta
+	if m.repoCursor < 0 {
+		m.repoCursor = 0
+	}
+	if m.repoCursor >= len(repos) {
+		m.repoCursor = len(repos) - 1
+	}
+}
+
+// selectedTopRepo returns the top repository under the cursor
+func (m Model) selectedTopRepo() (Repository, bool) {
+	repos := m.displayedTopRepos()
+	if m.repoCursor < 0 || m.repoCursor >= len(repos) {
+		return Repository{}, false
+	}
+	return repos[m.repoCursor], true
+}
+
+// openRepoDetail switches to the detail page of the repository fullName
+func (m *Model) openRepoDetail(fullName string) tea.Cmd {
+	if m.offline {
+		m.notice = "Repository details aren't available offline"
+		return nil
+	}
+	m.detailRepo = fullName
+	return m.reloadRepoDetail()
+}
+
+// reloadRepoDetail (re)fetches the open repository detail page
+func (m *Model) reloadRepoDetail() tea.Cmd {
+	m.repoDetail = nil
+	m.repoDetailErr = nil
+	m.loadingRepoDetail = true
+	m.refreshRepoViewport()
+	m.repoViewport.GotoTop()
+	return fetchRepoDetail(m.client, m.detailRepo)
+}
+
+// updateRepoDetail handles keys while the repository detail page is open
+func (m Model) updateRepoDetail(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "esc", "backspace":
+		// Back to the dashboard or repository browser
+		m.detailRepo = ""
+		m.repoDetail = nil
+		m.repoDetailErr = nil
+		m.loadingRepoDetail = false
+		return m, nil
+	case "r":
+		// Retry, e.g. once GitHub has computed commit activity
+		if m.loadingRepoDetail {
+			return m, nil
+		}
+		return m, m.reloadRepoDetail()
+	case "o", "O":
+		url := fmt.Sprintf("https://%s/%s", m.host, m.detailRepo)
+		if m.repoDetail != nil && m.repoDetail.HTMLURL != "" {
+			url = m.repoDetail.HTMLURL
+		}
+		return m, openURL(url)
+	}
+
+	// Everything else scrolls the page
+	var cmd tea.Cmd
+	m.repoViewport, cmd = m.repoViewport.Update(msg)
+	return m, cmd
+}
+
+// repoDetailMargin is the horizontal margin around the detail page
+const repoDetailMargin = 2
+
+// renderRepoDetail renders the full-screen repository detail page
+func (m Model) renderRepoDetail() string {
+	page := lipgloss.NewStyle().
+		PaddingLeft(repoDetailMargin).
+		Render(lipgloss.JoinVertical(lipgloss.Left, m.renderRepoDetailHeader(), m.repoViewport.View()))
+
+	// Pin the status bar to the bottom
+	gap := m.height - lipgloss.Height(page) - 1
+	if gap > 0 {
+		page += strings.Repeat("\n", gap)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, page, m.renderStatusBar(m.width))
+}
+
+// renderRepoDetailHeader renders the repository name, badges and description
+func (m Model) renderRepoDetailHeader() string {
+	width := m.width - repoDetailMargin*2
+
+	name := titleStyle.Render(m.detailRepo)
+	lines := []string{""}
+
+	detail := m.repoDetail
+	if detail == nil {
+		return lipgloss.JoinVertical(lipgloss.Left, append(lines, name, "")...)
+	}
+
+	var badges []string
+	if detail.Private {
+		badges = append(badges, "private")
+	}
+	if detail.Fork {
+		badges = append(badges, "fork")
+	}
+	if detail.Archived {
+		badges = append(badges, "archived")
+	}
+	for _, badge := range badges {
+		name += " " + lipgloss.NewStyle().
+			Foreground(lipgloss.Color(CurrentTheme.Yellow)).
+			Render("["+badge+"]")
+	}
+	lines = append(lines, name)
+
+	if detail.Description != "" {
+		for _, line := range wrapText(detail.Description, width) {
+			lines = append(lines, baseStyle.Render(line))
+		}
+	}
+	lines = append(lines, "")
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// repoDetailLines renders the scrollable body of the detail page
+func (m Model) repoDetailLines(width int) []string {
+	if m.loadingRepoDetail {
+		return []string{labelStyle.Render(m.spinner.View() + " Loading " + m.detailRepo + "...")}
+	}
+	if m.repoDetailErr != nil {
+		return []string{errorStyle.Render(wrapLine(m.repoDetailErr.Error(), width))}
+	}
+	detail := m.repoDetail
+	if detail == nil {
+		return nil
+	}
+
+	var lines []string
+
+	// Counts
+	stat := func(value int, label string) string {
+		return accentStyle.Render(fmt.Sprintf("%d", value)) + labelStyle.Render(" "+label)
+	}
+	sep := dimStyle.Render(" • ")
+	lines = append(lines, strings.Join([]string{
+		stat(detail.Stars, "stars"),
+		stat(detail.Forks, "forks"),
+		stat(detail.Watchers, "watching"),
+		stat(detail.OpenPRs, "open PRs"),
+		stat(detail.OpenIssues, "open issues"),
+	}, sep), "")
+
+	// Metadata
+	field := func(label, value string) string {
+		return labelStyle.Render(fmt.Sprintf("%-10s", label)) + baseStyle.Render(value)
+	}
+	license := detail.License
+	if license == "" {
+		license = "none"
+	}
+	lines = append(lines, field("License", license))
+	if release := detail.LatestRelease; release != nil {
+		value := release.Tag
+		if release.Name != "" && release.Name != release.Tag {
+			value += " — " + release.Name
+		}
+		if !release.PublishedAt.IsZero() {
+			value += " (" + formatTimeAgo(release.PublishedAt, false) + ")"
+		}
+		lines = append(lines, field("Release", value))
+	}
+	if !detail.PushedAt.IsZero() {
+		lines = append(lines, field("Pushed", formatTimeAgo(detail.PushedAt, false)))
+	}
+	if detail.Homepage != "" {
+		lines = append(lines, field("Homepage", detail.Homepage))
+	}
+	if len(detail.Topics) > 0 {
+		lines = append(lines, field("Topics", "")+m.renderTopics(detail.Topics, width-10))
+	}
+	lines = append(lines, "")
+
+	// Language bar
+	if len(detail.Languages) > 0 {
+		lines = append(lines, titleStyle.Render("Languages"))
+		lines = append(lines, renderLanguageBar(detail.Languages, min(width, 80)))
+		var legend []string
+		for _, lang := range detail.Languages {
+			dot := lipgloss.NewStyle().
+				Foreground(lipgloss.Color(CurrentTheme.LanguageColor(lang.Name, lang.Color))).
+				Render("●")
+			legend = append(legend, dot+baseStyle.Render(fmt.Sprintf(" %s %.1f%%", lang.Name, lang.Percentage*100)))
+		}
+		lines = append(lines, wrapStyled(legend, "  ", width)...)
+		lines = append(lines, "")
+	}
+
+	// Commit activity
+	lines = append(lines, titleStyle.Render("Commit Activity")+dimStyle.Render(" (last 52 weeks)"))
+	switch {
+	case detail.CommitsErr != nil:
+		lines = append(lines, errorStyle.Render(wrapLine(detail.CommitsErr.Error(), width)))
+	case detail.CommitsQueued:
+		lines = append(lines, labelStyle.Render("GitHub is computing commit statistics; press r to retry"))
+	case len(detail.CommitWeeks) == 0:
+		lines = append(lines, labelStyle.Render("No commits"))
+	default:
+		total := 0
+		for _, n := range detail.CommitWeeks {
+			total += n
+		}
+		lines = append(lines, lipgloss.NewStyle().
+			Foreground(lipgloss.Color(CurrentTheme.Green)).
+			Render(sparkline(detail.CommitWeeks)))
+		lines = append(lines, labelStyle.Render(fmt.Sprintf("%d commits", total)))
+	}
+	lines = append(lines, "")
+
+	// Contributors
+	if detail.ContributorsErr != nil {
+		lines = append(lines, titleStyle.Render("Contributors"))
+		lines = append(lines, errorStyle.Render(wrapLine(detail.ContributorsErr.Error(), width)), "")
+	} else if len(detail.Contributors) > 0 {
+		lines = append(lines, titleStyle.Render("Contributors"))
+		var contributors []string
+		for _, c := range detail.Contributors {
+			contributors = append(contributors, baseStyle.Render(c.Login)+dimStyle.Render(fmt.Sprintf(" %d", c.Contributions)))
+		}
+		lines = append(lines, wrapStyled(contributors, dimStyle.Render(" · "), width)...)
+		lines = append(lines, "")
+	}
+
+	// README
+	lines = append(lines, titleStyle.Render("README"))
+	if detail.ReadmeErr != nil {
+		lines = append(lines, errorStyle.Render(wrapLine(detail.ReadmeErr.Error(), width)))
+	} else if detail.Readme == "" {
+		lines = append(lines, labelStyle.Render("No README"))
+	} else {
+		lines = append(lines, renderMarkdown(detail.Readme, width))
+	}
+
+	return lines
+}
+
+// renderTopics renders repository topics as chips, wrapped to width
+func (m Model) renderTopics(topics []string, width int) string {
+	chip := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(CurrentTheme.Blue)).
+		Background(lipgloss.Color(CurrentTheme.Subtle)).
+		Padding(0, 1)
+
+	var chips []string
+	for _, topic := range topics {
+		chips = append(chips, chip.Render(topic))
+	}
+	return strings.Join(wrapStyled(chips, " ", width), "\n"+strings.Repeat(" ", 10))
+}
+
+// wrapStyled joins pre-rendered items with sep, starting a new line before
+// an item that would overflow width
+func wrapStyled(items []string, sep string, width int) []string {
+	var lines []string
+	line := ""
+	for _, item := range items {
+		switch {
+		case line == "":
+			line = item
+		case lipgloss.Width(line)+lipgloss.Width(sep)+lipgloss.Width(item) > width:
+			lines = append(lines, line)
+			line = item
+		default:
+			line += sep + item
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// renderLanguageBar renders languages as one stacked bar of width cells
+// Every language shown gets at least one cell; the largest absorbs rounding.
+func renderLanguageBar(languages []LanguageStats, width int) string {
+	if width < 1 || len(languages) == 0 {
+		return ""
+	}
+	if len(languages) > width {
+		languages = languages[:width]
+	}
+
+	cells := make([]int, len(languages))
+	used := 0
+	for i, lang := range languages {
+		cells[i] = max(1, int(lang.Percentage*float64(width)))
+		used += cells[i]
+	}
+	cells[0] += width - used
+
+	// The one-cell floor can overshoot width; shave the excess off the widest
+	for cells[0] < 1 {
+		widest := 1
+		for i := range cells {
+			if i > 0 && cells[i] > cells[widest] {
+				widest = i
+			}
+		}
+		cells[widest]--
+		cells[0]++
+	}
+
+	var b strings.Builder
+	for i, lang := range languages {
+		b.WriteString(lipgloss.NewStyle().
+			Background(lipgloss.Color(CurrentTheme.LanguageColor(lang.Name, lang.Color))).
+			Render(strings.Repeat(" ", cells[i])))
+	}
+	return b.String()
+}
+
+// renderMarkdown renders markdown for the terminal with glamour, in the
+// standard style matching the theme's background. Falls back to the raw text.
+func renderMarkdown(markdown string, width int) string {
+	style := "dark"
+	if relativeLuminance(CurrentTheme.Background) > 0.5 {
+		style = "light"
+	}
+
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle(style),
+		glamour.WithWordWrap(width),
+	)
+	if err != nil {
+		return markdown
+	}
+	out, err := renderer.Render(markdown)
+	if err != nil {
+		return markdown
+	}
+	return strings.Trim(out, "\n")
+}
+
+// refreshRepoViewport sizes the detail page viewport and re-renders its content
+func (m *Model) refreshRepoViewport() {
+	if !m.ready || m.detailRepo == "" {
+		return
+	}
+	width := max(1, m.width-repoDetailMargin*2)
+	m.repoViewport.Width = width
+	m.repoViewport.Height = max(1, m.height-lipgloss.Height(m.renderRepoDetailHeader())-1)
+	m.repoViewport.SetContent(strings.Join(m.repoDetailLines(width), "\n"))
+}
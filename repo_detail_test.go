@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestRenderLanguageBarFitsWidth(t *testing.T) {
+	languages := []LanguageStats{{Name: "Go", Percentage: 0.9}}
+	for i := 0; i < 9; i++ {
+		languages = append(languages, LanguageStats{Name: "Other", Percentage: 0.01})
+	}
+
+	for _, width := range []int{-3, 0, 1, 5, 10, 12, 80} {
+		bar := renderLanguageBar(languages, width)
+		if got := lipgloss.Width(bar); got != max(0, width) {
+			t.Errorf("width %d: bar is %d cells", width, got)
+		}
+	}
+
+	// One-cell floors for many small languages overshoot the largest's share
+	languages = []LanguageStats{{Name: "Go", Percentage: 0.35}, {Name: "Rust", Percentage: 0.35}}
+	for i := 0; i < 8; i++ {
+		languages = append(languages, LanguageStats{Name: "Other", Percentage: 0.03})
+	}
+	if got := lipgloss.Width(renderLanguageBar(languages, 10)); got != 10 {
+		t.Errorf("crowded bar is %d cells, want 10", got)
+	}
+}
@@ -0,0 +1,134 @@
+// Command genlanguages generates language_colors.go from GitHub linguist's languages.yml.
+//
+// Usage (from the repository root, normally via go generate):
+//
+//	go run ./scripts/genlanguages [-ref commit | -in languages.yml|URL] [-out language_colors.go]
+//
+// With -ref, languages.yml is fetched from that linguist commit and the commit
+// is recorded in the generated header, so the output is reproducible.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// linguistSource is the raw languages.yml URL for a linguist commit
+const linguistSource = "https://raw.githubusercontent.com/github-linguist/linguist/%s/lib/linguist/languages.yml"
+
+// language is the subset of a languages.yml entry we need
+type language struct {
+	Color   string   `yaml:"color"`
+	Aliases []string `yaml:"aliases"`
+}
+
+func main() {
+	ref := flag.String("ref", "main", "linguist commit (or branch) to fetch languages.yml from")
+	in := flag.String("in", "", "path or URL of linguist languages.yml, instead of -ref")
+	out := flag.String("out", "language_colors.go", "output Go file")
+	flag.Parse()
+
+	// The header names where the table came from
+	origin := fmt.Sprintf("linguist languages.yml (commit %s)", *ref)
+	if *in == "" {
+		*in = fmt.Sprintf(linguistSource, *ref)
+	} else {
+		origin = *in
+	}
+
+	data, err := readSource(*in)
+	if err != nil {
+		log.Fatalf("reading %s: %v", *in, err)
+	}
+
+	var languages map[string]language
+	if err := yaml.Unmarshal(data, &languages); err != nil {
+		log.Fatalf("parsing %s: %v", *in, err)
+	}
+
+	src, err := generate(languages, origin)
+	if err != nil {
+		log.Fatalf("generating: %v", err)
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatalf("writing %s: %v", *out, err)
+	}
+}
+
+// readSource reads languages.yml from a local path or an http(s) URL
+func readSource(source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return os.ReadFile(source)
+	}
+
+	resp, err := http.Get(source)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// generate renders the Go source for the color and alias tables
+// origin describes the source languages.yml in the generated header.
+func generate(languages map[string]language, origin string) ([]byte, error) {
+	names := make([]string, 0, len(languages))
+	for name := range languages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Every alias, plus the lowercased name itself, maps to the canonical name
+	aliases := make(map[string]string)
+	for _, name := range names {
+		aliases[strings.ToLower(name)] = name
+		for _, alias := range languages[name].Aliases {
+			alias = strings.ToLower(alias)
+			if _, taken := aliases[alias]; !taken {
+				aliases[alias] = name
+			}
+		}
+	}
+	aliasKeys := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		aliasKeys = append(aliasKeys, alias)
+	}
+	sort.Strings(aliasKeys)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by scripts/genlanguages from %s; DO NOT EDIT.\n\n", origin)
+	buf.WriteString("package main\n\n")
+
+	buf.WriteString("// linguistColors maps canonical linguist language names to their GitHub colors\n")
+	buf.WriteString("var linguistColors = map[string]string{\n")
+	for _, name := range names {
+		if color := languages[name].Color; color != "" {
+			fmt.Fprintf(&buf, "\t%q: %q,\n", name, color)
+		}
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString("// linguistAliases maps lowercased names and aliases to canonical linguist language names\n")
+	buf.WriteString("var linguistAliases = map[string]string{\n")
+	for _, alias := range aliasKeys {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", alias, aliases[alias])
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
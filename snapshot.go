@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Snapshot is the last successfully loaded dashboard for a user.
+// It is written after every complete load and rendered when the network
+// is unavailable or --offline is passed.
+type Snapshot struct {
+	Host          string              `json:"host"`
+	Username      string              `json:"username"`
+	PublicOnly    bool                `json:"public_only"`
+	SavedAt       time.Time           `json:"saved_at"`
+	Profile       *ProfileData        `json:"profile"`
+	Contributions []Contribution      `json:"contributions"`
+	Years         []int               `json:"years"`
+	Totals        *ContributionTotals `json:"totals,omitempty"`
+	SelectedYear  int                 `json:"selected_year"`
+	Repositories  []Repository        `json:"repositories"` // All repositories; languages and top repos are derived
+	Activities    []Activity          `json:"activities"`
+	PullRequests  []PullRequest       `json:"pull_requests,omitempty"`
+	Issues        []IssueSearch       `json:"issues,omitempty"`
+	AvatarPNG     []byte              `json:"avatar_png,omitempty"`
+}
+
+// snapshotDir returns the snapshot directory for a host under the XDG cache dir
+func snapshotDir(host string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gittui", "snapshots", host), nil
+}
+
+// snapshotPath returns the snapshot file for a username (logins are case-insensitive)
+func snapshotPath(host, username string) (string, error) {
+	dir, err := snapshotDir(host)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, strings.ToLower(username)+".json"), nil
+}
+
+// snapshotFromModel captures the model's currently loaded data
+func snapshotFromModel(m Model) *Snapshot {
+	snap := &Snapshot{
+		Host:          m.host,
+		Username:      m.username,
+		PublicOnly:    m.publicOnly,
+		SavedAt:       time.Now(),
+		Profile:       m.profile,
+		Contributions: m.contributions,
+		Years:         m.contribYears,
+		Totals:        m.contribTotals,
+		SelectedYear:  m.selectedYear,
+		Repositories:  m.allRepos,
+		Activities:    m.activities,
+		PullRequests:  m.pullRequests,
+		Issues:        m.issues,
+	}
+
+	if m.avatarImage != nil {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, m.avatarImage); err == nil {
+			snap.AvatarPNG = buf.Bytes()
+		}
+	}
+
+	return snap
+}
+
+// Avatar decodes the stored avatar, returning nil if none was saved
+func (s *Snapshot) Avatar() image.Image {
+	if len(s.AvatarPNG) == 0 {
+		return nil
+	}
+	img, err := png.Decode(bytes.NewReader(s.AvatarPNG))
+	if err != nil {
+		return nil
+	}
+	return img
+}
+
+// SaveSnapshot writes a snapshot to disk atomically
+func SaveSnapshot(snap *Snapshot) error {
+	path, err := snapshotPath(snap.Host, snap.Username)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// LoadSnapshot reads the saved snapshot for a username on a host
+func LoadSnapshot(host, username string) (*Snapshot, error) {
+	path, err := snapshotPath(host, username)
+	if err != nil {
+		return nil, err
+	}
+	return readSnapshot(path)
+}
+
+// LoadLatestSnapshot reads the most recently saved snapshot for any user on a host
+// Used by --offline when no username is given and gh auth can't be reached
+func LoadLatestSnapshot(host string) (*Snapshot, error) {
+	dir, err := snapshotDir(host)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var latest string
+	var latestTime time.Time
+	for _, path := range matches {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if info.ModTime().After(latestTime) {
+			latest = path
+			latestTime = info.ModTime()
+		}
+	}
+
+	if latest == "" {
+		return nil, fmt.Errorf("no saved snapshot found")
+	}
+	return readSnapshot(latest)
+}
+
+// readSnapshot decodes a snapshot file
+func readSnapshot(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("corrupt snapshot %s: %w", path, err)
+	}
+	return &snap, nil
+}
+
+// applySnapshot replaces the model's data with a snapshot and marks it stale
+func (m *Model) applySnapshot(snap *Snapshot) {
+	m.profile = snap.Profile
+	m.isOrg = snap.Profile != nil && snap.Profile.IsOrg
+	m.contributions = snap.Contributions
+	m.contribYears = snap.Years
+	m.contribTotals = snap.Totals
+	m.selectedYear = snap.SelectedYear
+	m.rebuildGraph(snap.Contributions)
+	m.setRepositories(snap.Repositories)
+	m.activities = snap.Activities
+	m.activityCursor = 0
+	m.pullRequests = snap.PullRequests
+	m.prCursor = 0
+	m.issues = snap.Issues
+	m.issueCursor = 0
+	m.avatarImage = snap.Avatar()
+	m.publicOnly = snap.PublicOnly
+	m.staleSince = snap.SavedAt
+	m.loading = loadingState{}
+	m.err = nil
+	if m.ready {
+		m.viewport.SetContent(m.renderActivityList())
+		m.viewport.GotoTop()
+		m.refreshPRViewport()
+		m.refreshIssueViewport()
+	}
+}
+
+// saveSnapshot persists the model's data in the background
+func saveSnapshot(snap *Snapshot) tea.Cmd {
+	return func() tea.Msg {
+		// Best-effort: a failed save only means no offline fallback next time
+		_ = SaveSnapshot(snap)
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	avatar := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	avatar.Set(0, 0, color.RGBA{R: 255, A: 255})
+
+	m := Model{
+		host:          "github.com",
+		username:      "Octocat",
+		publicOnly:    true,
+		profile:       &ProfileData{Login: "octocat", Followers: 7},
+		contributions: []Contribution{{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Count: 3}},
+		activities:    []Activity{{Type: "PushEvent", Repo: "octocat/hello"}},
+		avatarImage:   avatar,
+	}
+
+	if err := SaveSnapshot(snapshotFromModel(m)); err != nil {
+		t.Fatalf("SaveSnapshot: %v", err)
+	}
+
+	snap, err := LoadSnapshot("github.com", "octocat")
+	if err != nil {
+		t.Fatalf("LoadSnapshot: %v", err)
+	}
+
+	var restored Model
+	restored.applySnapshot(snap)
+
+	if restored.profile == nil || restored.profile.Followers != 7 {
+		t.Errorf("profile not restored: %+v", restored.profile)
+	}
+	if len(restored.contributions) != 1 || restored.graph == nil {
+		t.Errorf("contributions not restored")
+	}
+	if restored.avatarImage == nil {
+		t.Errorf("avatar not restored")
+	}
+	if !restored.publicOnly {
+		t.Errorf("public-only flag not restored")
+	}
+	if restored.staleSince.IsZero() {
+		t.Errorf("restored model should be marked stale")
+	}
+
+	latest, err := LoadLatestSnapshot("github.com")
+	if err != nil || latest.Username != "Octocat" {
+		t.Errorf("LoadLatestSnapshot = %v, %v", latest, err)
+	}
+}
+
+func TestFailedLoadFallsBackOnceEveryFetchReturns(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	saved := Model{host: "github.com", username: "octocat", profile: &ProfileData{Login: "octocat", Followers: 7}}
+	if err := SaveSnapshot(snapshotFromModel(saved)); err != nil {
+		t.Fatalf("SaveSnapshot: %v", err)
+	}
+
+	m := Model{host: "github.com", username: "octocat", loading: loadingState{profile: true, activities: true}}
+	fail := func() tea.Msg { return errMsg(errors.New("offline")) }
+	live := func() tea.Msg { return activitiesMsg([]Activity{{Type: "PushEvent"}}) }
+	batch := m.startLoad(fail, live)().(tea.BatchMsg)
+
+	updated, _ := m.Update(batch[0]())
+	m = updated.(Model)
+	if !m.staleSince.IsZero() || m.err != nil {
+		t.Fatalf("fell back while a fetch was still in flight")
+	}
+
+	updated, _ = m.Update(batch[1]())
+	m = updated.(Model)
+	if m.staleSince.IsZero() || m.profile == nil || m.profile.Followers != 7 {
+		t.Fatalf("expected the snapshot once the load finished, got profile %+v", m.profile)
+	}
+
+	// Results of an earlier load are dropped
+	updated, _ = m.Update(loadMsg{seq: m.loadSeq - 1, msg: profileMsg(&ProfileData{Login: "octocat"})})
+	if updated.(Model).profile.Followers != 7 {
+		t.Errorf("stale load result overwrote the snapshot")
+	}
+}
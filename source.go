@@ -0,0 +1,69 @@
+package main
+
+import (
+	"image"
+	"time"
+)
+
+// ProfileSource is the data backend the TUI reads from.
+// GitHubClient is the production implementation; fakes, recorded fixtures,
+// a local stand-in server, or a different forge can satisfy it as well
+// without any changes to the Bubble Tea code.
+type ProfileSource interface {
+	// FetchProfile fetches profile data for username
+	// includePrivate: if true, private counts are included (own profile only)
+	FetchProfile(username string, includePrivate bool) (*ProfileData, error)
+
+	// FetchContributions fetches the contribution calendar for username
+	// year: calendar year to fetch, or 0 for the last 12 months
+	FetchContributions(username string, year int) (*ContributionCalendar, error)
+
+	// FetchContributionDay fetches the commit/PR/issue/review breakdown for one day
+	FetchContributionDay(username string, date time.Time) (*DayContributions, error)
+
+	// FetchAllRepositories fetches every repository visible for the user
+	// Languages and top repositories are derived from it in memory
+	FetchAllRepositories(username string, includePrivate bool) ([]Repository, error)
+
+	// FetchRepoDetail fetches everything shown on a repository's detail page
+	// fullName: owner/name
+	FetchRepoDetail(fullName string) (*RepoDetail, error)
+
+	// FetchLanguageBytes fetches the language breakdown weighted by bytes of code
+	FetchLanguageBytes(username string, includePrivate bool) ([]LanguageStats, error)
+
+	// FetchRecentActivity fetches recent user activity
+	FetchRecentActivity(username string, includePrivate bool) ([]Activity, error)
+
+	// FetchOrgProfile fetches organization metadata and its public members
+	FetchOrgProfile(org string) (*ProfileData, error)
+
+	// FetchOrgContributions sums the contribution calendars of the given members
+	FetchOrgContributions(members []string, year int) (*ContributionCalendar, error)
+
+	// FetchOrgRepositories fetches every repository owned by the organization
+	FetchOrgRepositories(org string) ([]Repository, error)
+
+	// FetchOrgActivity fetches recent events in the organization's repositories
+	FetchOrgActivity(org string) ([]Activity, error)
+
+	// FetchPullRequests fetches open pull requests the user authored, reviews or is assigned
+	FetchPullRequests(username string) ([]PullRequest, error)
+
+	// FetchIssues fetches open issues assigned to, authored by and mentioning the user
+	FetchIssues(username string) ([]IssueSearch, error)
+
+	// FetchNotifications polls the authenticated user's unread notifications
+	FetchNotifications(lastModified string) (*NotificationPoll, error)
+
+	// MarkNotificationRead, MarkNotificationDone and UnsubscribeNotification act on a notification thread
+	MarkNotificationRead(threadID string) error
+	MarkNotificationDone(threadID string) error
+	UnsubscribeNotification(threadID string) error
+
+	// FetchAvatar fetches an avatar image resized to fit within size pixels
+	FetchAvatar(avatarURL string, size int) (image.Image, error)
+}
+
+// Compile-time check that GitHubClient satisfies ProfileSource
+var _ ProfileSource = (*GitHubClient)(nil)
@@ -34,57 +34,130 @@ type ActivityStats struct {
 	PushRate      float64
 	PRRate        float64
 	ReviewRate    float64
+	IssueRate     float64
+	RateSource    RateSource // Where PRRate, ReviewRate and IssueRate came from
 	PeakCodingHour string
 	HourDistribution map[int]int // hour -> activity count
 }
 
+// RateSource describes what PR, review and issue rates were averaged over
+type RateSource int
+
+const (
+	RateFromEvents        RateSource = iota // 7-day rolling average of the activity feed
+	RateFromContributions                   // contributionsCollection totals over the calendar period
+)
+
+// Hint returns a short description of how rates were averaged
+func (rs RateSource) Hint() string {
+	if rs == RateFromContributions {
+		return "(calendar period avg)"
+	}
+	return "(7-day avg)"
+}
+
+// Event types counted towards each rate
+var (
+	prRateEvents     = []string{"PullRequestEvent"}
+	reviewRateEvents = []string{"PullRequestReviewEvent", "PullRequestReviewCommentEvent"}
+	issueRateEvents  = []string{"IssuesEvent"}
+)
+
 // CalculatePushRate calculates push frequency based on 7-day rolling average
 // Daily rate: pushes in last 7 days / 7
 // Weekly rate: daily rate × 7
 // Monthly rate: daily rate × 30
 func CalculatePushRate(activities []Activity, granularity PushGranularity) float64 {
-	now := time.Now()
-	sevenDaysAgo := now.AddDate(0, 0, -7)
+	return CalculateEventRate(activities, []string{"PushEvent"}, granularity)
+}
 
-	// Count push events in the last 7 days
-	pushCount := 0
+// CalculateEventRate calculates the frequency of the given event types over
+// the last 7 days, projected to granularity like CalculatePushRate.
+// Pull request and issue events only count when they open something, so the
+// rate matches contributionsCollection totals.
+func CalculateEventRate(activities []Activity, eventTypes []string, granularity PushGranularity) float64 {
+	sevenDaysAgo := time.Now().AddDate(0, 0, -7)
+
+	count := 0
 	for _, activity := range activities {
-		if activity.Type == "PushEvent" && activity.Timestamp.After(sevenDaysAgo) {
-			pushCount++
+		if !activity.Timestamp.After(sevenDaysAgo) || !containsString(eventTypes, activity.Type) {
+			continue
 		}
+		if (activity.Type == "PullRequestEvent" || activity.Type == "IssuesEvent") &&
+			activity.Details.Action != "opened" {
+			continue
+		}
+		count++
 	}
 
-	if pushCount == 0 {
-		return 0.0
-	}
-
-	// Calculate daily average from last 7 days
-	dailyAverage := float64(pushCount) / 7.0
+	return projectDailyRate(float64(count)/7.0, granularity)
+}
 
-	// Project based on granularity
-	var rate float64
+// projectDailyRate converts a daily average to the given granularity
+func projectDailyRate(dailyAverage float64, granularity PushGranularity) float64 {
 	switch granularity {
 	case PushPerHour:
 		// Daily average / 24 hours
-		rate = dailyAverage / 24.0
-	case PushPerDay:
-		// Already calculated
-		rate = dailyAverage
+		return dailyAverage / 24.0
 	case PushPerWeek:
 		// Daily average × 7 days
-		rate = dailyAverage * 7.0
+		return dailyAverage * 7.0
 	case PushPerMonth:
 		// Daily average × 30 days
-		rate = dailyAverage * 30.0
+		return dailyAverage * 30.0
+	default:
+		return dailyAverage
+	}
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Days returns how many days back a time window reaches
+func (tw TimeWindow) Days() int {
+	switch tw {
+	case ThisMonth:
+		return 30
+	case ThisYear:
+		return 365
 	default:
-		rate = dailyAverage
+		return 7
 	}
+}
 
-	return rate
+// ActivityCoverage returns the timestamp of the oldest activity and how many
+// days back from now the activity feed reaches
+func ActivityCoverage(activities []Activity) (oldest time.Time, days int) {
+	for _, activity := range activities {
+		if oldest.IsZero() || activity.Timestamp.Before(oldest) {
+			oldest = activity.Timestamp
+		}
+	}
+	if oldest.IsZero() {
+		return oldest, 0
+	}
+	return oldest, int(time.Since(oldest).Hours()/24) + 1
+}
+
+// Next returns the following time window, wrapping around
+func (tw TimeWindow) Next() TimeWindow {
+	return (tw + 1) % (ThisYear + 1)
 }
 
 // CalculatePeakCodingHour analyzes activity timestamps to find the most active hour
-func CalculatePeakCodingHour(activities []Activity, window TimeWindow) (string, map[int]int) {
+// Hours are bucketed in loc (nil means local time) since API timestamps are UTC
+func CalculatePeakCodingHour(activities []Activity, window TimeWindow, loc *time.Location) (string, map[int]int) {
+	if loc == nil {
+		loc = time.Local
+	}
+
 	now := time.Now()
 	var cutoff time.Time
 
@@ -110,7 +183,7 @@ func CalculatePeakCodingHour(activities []Activity, window TimeWindow) (string,
 			continue
 		}
 
-		hour := activity.Timestamp.Hour()
+		hour := activity.Timestamp.In(loc).Hour()
 		hourCounts[hour]++
 	}
 
@@ -183,15 +256,15 @@ func CalculatePeakCodingHour(activities []Activity, window TimeWindow) (string,
 
 // formatHourRange formats hour range nicely
 func formatHourRange(start int, startPeriod string, end int, endPeriod string) string {
-	// Convert to lowercase for consistency
-	startPeriod = strings.ToLower(startPeriod)
-	endPeriod = strings.ToLower(endPeriod)
+	// Convert to uppercase for consistency
+	startPeriod = strings.ToUpper(startPeriod)
+	endPeriod = strings.ToUpper(endPeriod)
 
 	if startPeriod == endPeriod {
-		// Same period: "2-3pm"
+		// Same period: "2-3PM"
 		return fmt.Sprintf("%d-%d%s", start, end, endPeriod)
 	}
-	// Different periods: "11am-12pm"
+	// Different periods: "11AM-12PM"
 	return fmt.Sprintf("%d%s-%d%s", start, startPeriod, end, endPeriod)
 }
 
@@ -201,18 +274,37 @@ func formatTime(hour int) string {
 }
 
 // CalculateActivityStats calculates all activity statistics
-func CalculateActivityStats(activities []Activity, pushGranularity PushGranularity, timeWindow TimeWindow) ActivityStats {
+func CalculateActivityStats(activities []Activity, pushGranularity PushGranularity, timeWindow TimeWindow, loc *time.Location) ActivityStats {
 	stats := ActivityStats{}
 
 	// Calculate push rate
 	stats.PushRate = CalculatePushRate(activities, pushGranularity)
 
 	// Calculate peak coding hour
-	peakHour, distribution := CalculatePeakCodingHour(activities, timeWindow)
+	peakHour, distribution := CalculatePeakCodingHour(activities, timeWindow, loc)
 	stats.PeakCodingHour = peakHour
 	stats.HourDistribution = distribution
 
-	// TODO: Add PR rate, review rate, etc.
+	// Calculate PR, review and issue rates from the activity feed
+	stats.PRRate = CalculateEventRate(activities, prRateEvents, pushGranularity)
+	stats.ReviewRate = CalculateEventRate(activities, reviewRateEvents, pushGranularity)
+	stats.IssueRate = CalculateEventRate(activities, issueRateEvents, pushGranularity)
+	stats.RateSource = RateFromEvents
 
 	return stats
 }
+
+// ApplyContributionTotals replaces the event-based PR, review and issue rates
+// with averages over contributionsCollection totals, which aren't limited by
+// the size of the activity feed
+func (s *ActivityStats) ApplyContributionTotals(totals *ContributionTotals, granularity PushGranularity) {
+	days := totals.Days()
+	if days <= 0 {
+		return
+	}
+
+	s.PRRate = projectDailyRate(float64(totals.PullRequests)/days, granularity)
+	s.ReviewRate = projectDailyRate(float64(totals.Reviews)/days, granularity)
+	s.IssueRate = projectDailyRate(float64(totals.Issues)/days, granularity)
+	s.RateSource = RateFromContributions
+}
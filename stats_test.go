@@ -52,14 +52,14 @@ func TestCalculatePeakCodingHour(t *testing.T) {
 	// Create test activities with known timestamps
 	now := time.Now()
 	activities := []Activity{
-		{Type: "PushEvent", Timestamp: now.Add(-1 * time.Hour)},        // Hour: now-1
-		{Type: "PushEvent", Timestamp: now.Add(-2 * time.Hour)},        // Hour: now-2
-		{Type: "PushEvent", Timestamp: now.Add(-2 * time.Hour)},        // Hour: now-2 (duplicate)
-		{Type: "IssueEvent", Timestamp: now.Add(-3 * time.Hour)},       // Hour: now-3
-		{Type: "PushEvent", Timestamp: now.Add(-50 * time.Hour)},       // Outside ThisWeek window
+		{Type: "PushEvent", Timestamp: now.Add(-1 * time.Hour)},  // Hour: now-1
+		{Type: "PushEvent", Timestamp: now.Add(-2 * time.Hour)},  // Hour: now-2
+		{Type: "PushEvent", Timestamp: now.Add(-2 * time.Hour)},  // Hour: now-2 (duplicate)
+		{Type: "IssueEvent", Timestamp: now.Add(-3 * time.Hour)}, // Hour: now-3
+		{Type: "PushEvent", Timestamp: now.AddDate(0, 0, -8)},    // Outside ThisWeek window
 	}
 
-	peakHour, distribution := CalculatePeakCodingHour(activities, ThisWeek)
+	peakHour, distribution := CalculatePeakCodingHour(activities, ThisWeek, time.Local)
 
 	// Should have counted 4 activities within the week
 	totalCount := 0
@@ -85,3 +85,57 @@ func TestCalculatePeakCodingHour(t *testing.T) {
 	t.Logf("Peak hour: %s", peakHour)
 	t.Logf("Distribution: %v", distribution)
 }
+
+func TestCalculateActivityStatsRates(t *testing.T) {
+	now := time.Now()
+	activities := []Activity{
+		{Type: "PullRequestEvent", Details: EventDetails{Action: "opened"}, Timestamp: now.Add(-time.Hour)},
+		{Type: "PullRequestEvent", Details: EventDetails{Action: "closed"}, Timestamp: now.Add(-time.Hour)},
+		{Type: "PullRequestReviewEvent", Timestamp: now.Add(-2 * time.Hour)},
+		{Type: "PullRequestReviewCommentEvent", Timestamp: now.Add(-3 * time.Hour)},
+		{Type: "IssuesEvent", Details: EventDetails{Action: "opened"}, Timestamp: now.Add(-24 * time.Hour)},
+		{Type: "IssuesEvent", Details: EventDetails{Action: "opened"}, Timestamp: now.AddDate(0, 0, -10)}, // Outside 7 days
+	}
+
+	stats := CalculateActivityStats(activities, PushPerWeek, ThisWeek, time.UTC)
+	if stats.PRRate != 1 || stats.ReviewRate != 2 || stats.IssueRate != 1 {
+		t.Errorf("weekly rates = PR %.2f, review %.2f, issue %.2f; want 1, 2, 1",
+			stats.PRRate, stats.ReviewRate, stats.IssueRate)
+	}
+	if stats.RateSource != RateFromEvents {
+		t.Errorf("expected event-based rates")
+	}
+
+	totals := &ContributionTotals{
+		PullRequests: 70,
+		Reviews:      140,
+		Issues:       7,
+		StartedAt:    now.AddDate(0, 0, -70),
+		EndedAt:      now.AddDate(0, 0, 30), // Future part of the period is ignored
+	}
+	stats.ApplyContributionTotals(totals, PushPerWeek)
+	if stats.RateSource != RateFromContributions {
+		t.Errorf("expected contribution-based rates")
+	}
+	if stats.PRRate < 6.99 || stats.PRRate > 7.01 || stats.ReviewRate < 13.99 || stats.ReviewRate > 14.01 {
+		t.Errorf("totals rates = PR %.2f, review %.2f; want 7, 14", stats.PRRate, stats.ReviewRate)
+	}
+}
+
+func TestCalculatePeakCodingHourTimezone(t *testing.T) {
+	// Half past the previous hour, which each zone buckets at its own local
+	// hour (Tokyo is UTC+9, New York UTC-5 or UTC-4 depending on DST)
+	ts := time.Now().UTC().Add(-time.Hour).Truncate(time.Hour).Add(30 * time.Minute)
+	activities := []Activity{{Type: "PushEvent", Timestamp: ts}}
+
+	for _, name := range []string{"UTC", "Asia/Tokyo", "America/New_York"} {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Skipf("timezone data unavailable: %v", err)
+		}
+		_, distribution := CalculatePeakCodingHour(activities, ThisWeek, loc)
+		if want := ts.In(loc).Hour(); distribution[want] != 1 {
+			t.Errorf("%s: expected activity at hour %d, got %v", name, want, distribution)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/mattn/go-runewidth"
+)
+
+// tableSeparator divides table cells
+const tableSeparator = " │ "
+
+// tableColumn describes a column of a responsive table
+type tableColumn struct {
+	title    string
+	short    string // Title used when the column is narrower than title
+	min      int    // Narrowest useful width
+	max      int    // Widest the column grows to; 0 takes all remaining space
+	priority int    // Columns with the lowest priority are dropped first
+}
+
+// layoutColumns sizes columns to fit total display cells.
+// Columns are dropped (width 0) lowest priority first until every remaining
+// column fits at its minimum; leftover space then grows columns towards
+// their max in order, with unbounded columns taking whatever is left.
+func layoutColumns(columns []tableColumn, total int) []int {
+	widths := make([]int, len(columns))
+	visible := make([]bool, len(columns))
+	for i := range columns {
+		visible[i] = true
+	}
+
+	required := func() int {
+		sum, count := 0, 0
+		for i, col := range columns {
+			if visible[i] {
+				sum += col.min
+				count++
+			}
+		}
+		if count > 1 {
+			sum += (count - 1) * runewidth.StringWidth(tableSeparator)
+		}
+		return sum
+	}
+
+	for required() > total {
+		drop := -1
+		remaining := 0
+		for i, col := range columns {
+			if !visible[i] {
+				continue
+			}
+			remaining++
+			if drop == -1 || col.priority < columns[drop].priority {
+				drop = i
+			}
+		}
+		// Always keep the most important column, squeezed if necessary
+		if remaining <= 1 {
+			break
+		}
+		visible[drop] = false
+	}
+
+	spare := total - required()
+	for i, col := range columns {
+		if visible[i] {
+			widths[i] = col.min
+		}
+	}
+
+	// Grow bounded columns first, then hand the rest to unbounded ones
+	for i, col := range columns {
+		if !visible[i] || col.max == 0 || spare <= 0 {
+			continue
+		}
+		grow := col.max - col.min
+		if grow > spare {
+			grow = spare
+		}
+		widths[i] += grow
+		spare -= grow
+	}
+	for i, col := range columns {
+		if visible[i] && col.max == 0 && spare > 0 {
+			widths[i] += spare
+			spare = 0
+		}
+	}
+
+	// A lone column that still doesn't fit is squeezed to the total
+	if spare < 0 {
+		for i := range widths {
+			if widths[i] > 0 {
+				widths[i] += spare
+				if widths[i] < 1 {
+					widths[i] = 1
+				}
+				break
+			}
+		}
+	}
+
+	return widths
+}
+
+// renderTableHeader renders column titles, using short titles where they don't fit
+func renderTableHeader(columns []tableColumn, widths []int, style, sepStyle lipgloss.Style) string {
+	cells := make([]string, len(columns))
+	for i, col := range columns {
+		title := col.title
+		if runewidth.StringWidth(title) > widths[i] && col.short != "" {
+			title = col.short
+		}
+		cells[i] = title
+	}
+	return renderTableRow(cells, widths, func(int) lipgloss.Style { return style }, sepStyle)
+}
+
+// renderTableRow fits each cell to its column width and joins the visible cells
+func renderTableRow(cells []string, widths []int, style func(col int) lipgloss.Style, sepStyle lipgloss.Style) string {
+	var parts []string
+	for i, cell := range cells {
+		if widths[i] == 0 {
+			continue
+		}
+		parts = append(parts, style(i).Render(padRight(truncate(cell, widths[i]), widths[i])))
+	}
+	return strings.Join(parts, sepStyle.Render(tableSeparator))
+}
+
+// renderTableSeparator renders the horizontal rule under a table header
+func renderTableSeparator(widths []int, style lipgloss.Style) string {
+	var parts []string
+	for _, width := range widths {
+		if width > 0 {
+			parts = append(parts, strings.Repeat("─", width))
+		}
+	}
+	return style.Render(strings.Join(parts, "─┼─"))
+}
+
+// truncate shortens s to at most width display cells, ending with an ellipsis when cut.
+// Styling escapes in s (e.g. colored labels) don't count towards the width.
+func truncate(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	return ansi.TruncateWc(s, width, "…")
+}
+
+// padRight pads s with spaces to width display cells
+func padRight(s string, width int) string {
+	if pad := width - ansi.StringWidthWc(s); pad > 0 {
+		return s + strings.Repeat(" ", pad)
+	}
+	return s
+}
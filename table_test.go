@@ -0,0 +1,63 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/mattn/go-runewidth"
+)
+
+func TestLayoutColumns(t *testing.T) {
+	columns := activityColumns()
+	sep := runewidth.StringWidth(tableSeparator)
+
+	tests := []struct {
+		total int
+		want  []int
+	}{
+		// Wide: bounded columns reach their max, Action takes the rest
+		{120, []int{10, 22, 30, 120 - 10 - 22 - 30 - 3*sep}},
+		// Everything at minimum fits exactly
+		{8 + 8 + 12 + 16 + 3*sep, []int{8, 8, 12, 16}},
+		// One cell short: Event is dropped first
+		{8 + 8 + 12 + 16 + 3*sep - 1, []int{10, 0, 20, 16}},
+		// Narrower still: only Time and Action remain
+		{30, []int{10, 0, 0, 30 - 10 - sep}},
+		// Too narrow for two columns: Action alone, squeezed
+		{10, []int{0, 0, 0, 10}},
+	}
+
+	for _, tt := range tests {
+		got := layoutColumns(columns, tt.total)
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Errorf("layoutColumns(%d) = %v, want %v", tt.total, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestTruncateByDisplayWidth(t *testing.T) {
+	// Each CJK rune is two cells wide
+	got := truncate("日本語のリポジトリ", 7)
+	if w := runewidth.StringWidth(got); w > 7 {
+		t.Errorf("truncate returned %q (%d cells), want at most 7", got, w)
+	}
+	if got := padRight(truncate("日本語", 10), 10); runewidth.StringWidth(got) != 10 {
+		t.Errorf("padRight width = %d, want 10", runewidth.StringWidth(got))
+	}
+	if got := truncate("short", 10); got != "short" {
+		t.Errorf("truncate changed a string that fits: %q", got)
+	}
+}
+
+func TestTruncateIgnoresStyling(t *testing.T) {
+	styled := "\x1b[41mbug\x1b[0m \x1b[44menhancement\x1b[0m"
+	if got := padRight(styled, 20); ansi.StringWidth(got) != 20 {
+		t.Errorf("padRight width = %d, want 20", ansi.StringWidth(got))
+	}
+	if got := ansi.Strip(truncate(styled, 8)); got != "bug enh…" {
+		t.Errorf("truncate = %q, want %q", got, "bug enh…")
+	}
+}
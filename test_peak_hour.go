@@ -31,7 +31,7 @@ func main() {
 
 	for _, window := range windows {
 		fmt.Printf("\n--- %s ---\n", window.String())
-		peakHour, distribution := CalculatePeakCodingHour(activities, window)
+		peakHour, distribution := CalculatePeakCodingHour(activities, window, time.Local)
 
 		fmt.Printf("Peak Hour: %s\n", peakHour)
 		fmt.Printf("Distribution: %v\n", distribution)
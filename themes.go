@@ -1,8 +1,10 @@
 package main
 
 import (
+	"math"
 	"os"
 	"sort"
+	"strings"
 
 	goghthemes "github.com/willyv3/gogh-themes"
 )
@@ -45,8 +47,14 @@ type Theme struct {
 	ContribMed    string
 	ContribHigh   string
 	ContribHigher string
+
+	// Language bar color remaps (canonical linguist name -> hex color)
+	LanguageColors map[string]string
 }
 
+// minLanguageContrast is the contrast ratio a language bar needs against the background
+const minLanguageContrast = 1.8
+
 // themes registry - all themes from gogh-themes package
 var themes = make(map[string]Theme)
 
@@ -82,10 +90,98 @@ func InitTheme() {
 		}
 	}
 
+	// Apply user language color remaps to every theme
+	if remaps := parseLanguageColors(os.Getenv("GITTUI_LANGUAGE_COLORS")); len(remaps) > 0 {
+		for name, t := range themes {
+			t.LanguageColors = remaps
+			themes[name] = t
+		}
+		theme = themes[themeName]
+	}
+
 	CurrentTheme = theme
 	currentThemeName = themeName
 }
 
+// parseLanguageColors parses "Go=#00ADD8,Shell=#333333" into a remap table
+// Language names may be linguist aliases; they are resolved to canonical names
+func parseLanguageColors(spec string) map[string]string {
+	remaps := make(map[string]string)
+	for _, pair := range strings.Split(spec, ",") {
+		lang, color, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || lang == "" || color == "" {
+			continue
+		}
+		if name, ok := linguistAliases[strings.ToLower(lang)]; ok {
+			lang = name
+		}
+		remaps[lang] = color
+	}
+	return remaps
+}
+
+// LanguageColor returns the bar color for a language under this theme
+// Theme remaps win; otherwise the linguist color is shaded until it is
+// legible against the theme background (e.g. yellow bars on light themes).
+func (t Theme) LanguageColor(lang, color string) string {
+	if remap, ok := t.LanguageColors[lang]; ok {
+		return remap
+	}
+	if name, ok := linguistAliases[strings.ToLower(lang)]; ok {
+		if remap, ok := t.LanguageColors[name]; ok {
+			return remap
+		}
+	}
+	return ensureContrast(color, t.Background, minLanguageContrast)
+}
+
+// ensureContrast darkens (light backgrounds) or brightens (dark backgrounds)
+// color until it reaches minRatio against background
+func ensureContrast(color, background string, minRatio float64) string {
+	if len(color) != 7 || len(background) != 7 {
+		return color
+	}
+
+	factor := 1.25
+	if relativeLuminance(background) > 0.5 {
+		factor = 0.8
+	}
+
+	for i := 0; i < 8 && contrastRatio(color, background) < minRatio; i++ {
+		color = generateShade(color, factor)
+	}
+	return color
+}
+
+// contrastRatio returns the WCAG contrast ratio between two hex colors
+func contrastRatio(a, b string) float64 {
+	la := relativeLuminance(a)
+	lb := relativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// relativeLuminance returns the WCAG relative luminance of a #RRGGBB color
+func relativeLuminance(hexColor string) float64 {
+	hexColor = strings.TrimPrefix(hexColor, "#")
+	if len(hexColor) != 6 {
+		return 0
+	}
+
+	channel := func(s string) float64 {
+		v, _ := parseHex(s)
+		c := float64(v) / 255
+		if c <= 0.03928 {
+			return c / 12.92
+		}
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+
+	return 0.2126*channel(hexColor[0:2]) + 0.7152*channel(hexColor[2:4]) + 0.0722*channel(hexColor[4:6])
+}
+
 // loadAllThemes loads all themes from gogh-themes package
 func loadAllThemes() {
 	allGoghThemes := goghthemes.All()
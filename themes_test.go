@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestGetLanguageColorUsesLinguistTable(t *testing.T) {
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{"Go", "#00ADD8"},
+		{"Zig", "#ec915c"},
+		{"golang", "#00ADD8"}, // alias
+		{"Not A Language", "#858585"},
+	}
+
+	for _, tt := range tests {
+		if got := getLanguageColor(tt.lang); got != tt.want {
+			t.Errorf("getLanguageColor(%q) = %s, want %s", tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestThemeLanguageColor(t *testing.T) {
+	light := Theme{Background: "#FFFFFF"}
+
+	// JavaScript yellow is nearly invisible on white and must be darkened
+	adjusted := light.LanguageColor("JavaScript", "#f1e05a")
+	if contrastRatio(adjusted, light.Background) < minLanguageContrast {
+		t.Errorf("expected legible color on light theme, got %s (ratio %.2f)",
+			adjusted, contrastRatio(adjusted, light.Background))
+	}
+
+	// Already legible colors are left untouched
+	if got := light.LanguageColor("Go", "#00ADD8"); got != "#00ADD8" {
+		t.Errorf("legible color was changed to %s", got)
+	}
+
+	// Theme remaps win, including via aliases
+	light.LanguageColors = parseLanguageColors("golang=#112233, Shell=#445566")
+	if got := light.LanguageColor("Go", "#00ADD8"); got != "#112233" {
+		t.Errorf("remap not applied: %s", got)
+	}
+	if got := light.LanguageColor("Shell", "#89e051"); got != "#445566" {
+		t.Errorf("remap not applied: %s", got)
+	}
+}